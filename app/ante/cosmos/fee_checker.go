@@ -125,10 +125,10 @@ func (dfd DeductFeeDecorator) deductFee(ctx cosmos.Context, sdkTx cosmos.Tx, fee
 		return errortypes.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	// TODO mark deduct the fees
-	// if err := deductFeesFromBalanceOrUnclaimedStakingRewards(ctx, dfd, deductFeesFromAcc, fees); err != nil {
-	//	return fmt.Errorf("insufficient funds and failed to claim sufficient staking rewards to pay for fees: %w", err)
-	// }
+	// deduct the fees
+	if err := deductFeesFromBalanceOrUnclaimedStakingRewards(ctx, dfd, deductFeesFromAcc, fees); err != nil {
+		return fmt.Errorf("insufficient funds and failed to claim sufficient staking rewards to pay for fees: %w", err)
+	}
 
 	events := cosmos.Events{
 		cosmos.NewEvent(
@@ -144,8 +144,6 @@ func (dfd DeductFeeDecorator) deductFee(ctx cosmos.Context, sdkTx cosmos.Tx, fee
 
 // deductFeesFromBalanceOrUnclaimedStakingRewards tries to deduct the fees from the account balance.
 // If the account balance is not enough, it tries to claim enough staking rewards to cover the fees.
-//
-//nolint:unused
 func deductFeesFromBalanceOrUnclaimedStakingRewards(
 	ctx cosmos.Context, dfd DeductFeeDecorator, deductFeesFromAcc authtypes.AccountI, fees cosmos.Coins,
 ) error {
@@ -155,8 +153,7 @@ func deductFeesFromBalanceOrUnclaimedStakingRewards(
 		return err
 	}
 
-	// TODO mark return authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees)
-	return nil
+	return authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees)
 }
 
 // checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
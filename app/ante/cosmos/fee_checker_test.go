@@ -0,0 +1,228 @@
+package cosmos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	cosmosante "github.com/artela-network/artela/app/ante/cosmos"
+)
+
+const testDenom = "aart"
+
+var feeCollector = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+type mockAccountKeeper struct {
+	accounts map[string]authtypes.AccountI
+}
+
+func (ak mockAccountKeeper) GetParams(sdk.Context) authtypes.Params { return authtypes.DefaultParams() }
+func (ak mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return ak.accounts[addr.String()]
+}
+func (ak mockAccountKeeper) SetAccount(_ sdk.Context, acc authtypes.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
+func (ak mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk mockBankKeeper) IsSendEnabledCoins(sdk.Context, ...sdk.Coin) error { return nil }
+func (bk mockBankKeeper) SendCoins(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return errortypes.ErrInsufficientFunds.Wrapf("%s is smaller than %s", bk.balances[from.String()], amt)
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+func (bk mockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return bk.SendCoins(ctx, from, authtypes.NewModuleAddress(module), amt)
+}
+func (bk mockBankKeeper) GetBalance(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bk.balances[addr.String()].AmountOf(denom))
+}
+
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) BondDenom(sdk.Context) string { return testDenom }
+func (mockStakingKeeper) IterateDelegations(sdk.Context, sdk.AccAddress, func(int64, stakingtypes.DelegationI) bool) {
+}
+
+type mockDistributionKeeper struct{}
+
+func (mockDistributionKeeper) WithdrawDelegationRewards(sdk.Context, sdk.AccAddress, sdk.ValAddress) (sdk.Coins, error) {
+	return nil, errors.New("no rewards")
+}
+
+type mockFeegrantKeeper struct {
+	// grants maps the granter to the grantee allowed to use its fees
+	grants map[string]string
+}
+
+func (fk mockFeegrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, _ sdk.Coins, _ []sdk.Msg) error {
+	if fk.grants[granter.String()] != grantee.String() {
+		return errors.New("fee-grant not found")
+	}
+	return nil
+}
+
+type mockUpgradeKeeper struct {
+	doneHeight int64
+}
+
+func (uk mockUpgradeKeeper) GetDoneHeight(sdk.Context, string) int64 { return uk.doneHeight }
+
+type anteTestSuite struct {
+	txConfig client.TxConfig
+	ak       mockAccountKeeper
+	bk       mockBankKeeper
+	fk       mockFeegrantKeeper
+}
+
+func newAnteTestSuite() *anteTestSuite {
+	return &anteTestSuite{
+		txConfig: app.MakeConfig(app.ModuleBasics).TxConfig,
+		ak:       mockAccountKeeper{accounts: make(map[string]authtypes.AccountI)},
+		bk:       mockBankKeeper{balances: make(map[string]sdk.Coins)},
+		fk:       mockFeegrantKeeper{grants: make(map[string]string)},
+	}
+}
+
+// newAccount creates an account with the balance.
+func (s *anteTestSuite) newAccount(balance int64) sdk.AccAddress {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.ak.accounts[addr.String()] = authtypes.NewBaseAccountWithAddress(addr)
+	s.bk.balances[addr.String()] = sdk.NewCoins(sdk.NewInt64Coin(testDenom, balance))
+	return addr
+}
+
+func (s *anteTestSuite) newTx(t *testing.T, fee int64, granter sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+	builder := s.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(100000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(testDenom, fee)))
+	builder.SetFeeGranter(granter)
+	return builder.GetTx()
+}
+
+func (s *anteTestSuite) anteHandler(doneHeight int64) sdk.AnteHandler {
+	uk := mockUpgradeKeeper{doneHeight: doneHeight}
+	return sdk.ChainAnteDecorators(
+		cosmosante.NewUpgradeGateDecorator(uk, "upgrade",
+			cosmosante.NewDeductFeeDecorator(s.ak, s.bk, mockDistributionKeeper{}, s.fk, mockStakingKeeper{}, nil),
+		),
+		cosmosante.NewUpgradeGateDecorator(uk, "upgrade",
+			cosmosante.NewVestingDelegationDecorator(s.ak, mockStakingKeeper{}),
+		),
+	)
+}
+
+func newAnteContext() sdk.Context {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	return ctx.WithBlockHeader(tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+}
+
+func TestDeductFeeDecorator(t *testing.T) {
+	testCases := []struct {
+		name       string
+		doneHeight int64
+		balance    int64
+		fee        int64
+		useGrant   bool
+		grant      bool
+		expErr     bool
+		expPayer   int64
+		expGranter int64
+	}{
+		{"success, fee deducted", 5, 100, 40, false, false, false, 60, 100},
+		{"success, no fee deducted before the upgrade", 0, 100, 40, false, false, false, 100, 100},
+		{"success, no fee deducted before the upgrade, insufficient funds", 0, 10, 40, false, false, false, 10, 100},
+		{"fail, insufficient funds", 5, 10, 40, false, false, true, 10, 100},
+		{"success, fee deducted from the granter", 5, 10, 40, true, true, false, 10, 60},
+		{"fail, fee not granted", 5, 100, 40, true, false, true, 100, 100},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newAnteTestSuite()
+			payer, granter := s.newAccount(tc.balance), s.newAccount(100)
+			if tc.grant {
+				s.fk.grants[granter.String()] = payer.String()
+			}
+
+			var feeGranter sdk.AccAddress
+			if tc.useGrant {
+				feeGranter = granter
+			}
+			tx := s.newTx(t, tc.fee, feeGranter, banktypes.NewMsgSend(payer, granter, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1))))
+
+			_, err := s.anteHandler(tc.doneHeight)(newAnteContext(), tx, false)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expPayer, s.bk.balances[payer.String()].AmountOf(testDenom).Int64())
+			require.Equal(t, tc.expGranter, s.bk.balances[granter.String()].AmountOf(testDenom).Int64())
+			collected := tc.balance + 100 - tc.expPayer - tc.expGranter
+			require.Equal(t, collected, s.bk.balances[feeCollector.String()].AmountOf(testDenom).Int64())
+		})
+	}
+}
+
+func TestVestingDelegationDecorator(t *testing.T) {
+	validator := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name       string
+		doneHeight int64
+		amount     int64
+		expErr     bool
+	}{
+		{"success, vested coins delegated", 5, 50, false},
+		{"fail, unvested coins delegated", 5, 51, true},
+		{"success, unvested coins delegated before the upgrade", 0, 51, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newAnteTestSuite()
+			delegator := s.newAccount(1000)
+
+			// half of the coins are vested at the block time
+			baseAcc := authtypes.NewBaseAccountWithAddress(delegator)
+			s.ak.accounts[delegator.String()] = vestingtypes.NewContinuousVestingAccount(
+				baseAcc, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)), 500, 1500,
+			)
+
+			tx := s.newTx(t, 0, nil, stakingtypes.NewMsgDelegate(delegator, validator, sdk.NewInt64Coin(testDenom, tc.amount)))
+			_, err := s.anteHandler(tc.doneHeight)(newAnteContext(), tx, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, errortypes.ErrInsufficientFunds)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package cosmos
 
-import cosmos "github.com/cosmos/cosmos-sdk/types"
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
 
 // BankKeeper defines the exposed interface for using functionality of the bank keeper
 // in the context of the cosmos AnteHandler package.
 type BankKeeper interface {
+	authtypes.BankKeeper
 	GetBalance(ctx cosmos.Context, addr cosmos.AccAddress, denom string) cosmos.Coin
-	SendCoins(ctx cosmos.Context, from, to cosmos.AccAddress, amt cosmos.Coins) error
-	SendCoinsFromAccountToModule(ctx cosmos.Context, senderAddr cosmos.AccAddress, recipientModule string, amt cosmos.Coins) error
 }
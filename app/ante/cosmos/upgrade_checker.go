@@ -0,0 +1,35 @@
+package cosmos
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	anteutils "github.com/artela-network/artela/app/ante/utils"
+)
+
+// UpgradeGateDecorator runs the wrapped decorator only once the upgrade has been applied,
+// the txs of the blocks before the upgrade are handled as they were when the blocks were
+// produced, so that the blocks are replayed with the same app hash.
+type UpgradeGateDecorator struct {
+	uk        anteutils.UpgradeKeeper
+	name      string
+	decorator cosmos.AnteDecorator
+}
+
+// NewUpgradeGateDecorator creates a new UpgradeGateDecorator enabling the decorator with the upgrade.
+func NewUpgradeGateDecorator(uk anteutils.UpgradeKeeper, name string, decorator cosmos.AnteDecorator) UpgradeGateDecorator {
+	return UpgradeGateDecorator{
+		uk:        uk,
+		name:      name,
+		decorator: decorator,
+	}
+}
+
+// AnteHandle calls the wrapped decorator if the upgrade is done, or the next AnteHandler otherwise.
+func (ugd UpgradeGateDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (cosmos.Context, error) {
+	// the lookup is not charged to the tx, the gas used before the upgrade is kept unchanged
+	if ugd.uk.GetDoneHeight(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), ugd.name) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	return ugd.decorator.AnteHandle(ctx, tx, simulate, next)
+}
@@ -0,0 +1,98 @@
+package cosmos
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	anteutils "github.com/artela-network/artela/app/ante/utils"
+)
+
+// VestingDelegationDecorator checks the delegations of vesting accounts, only the vested
+// coins of a vesting account are allowed to be delegated.
+type VestingDelegationDecorator struct {
+	ak authante.AccountKeeper
+	sk anteutils.StakingKeeper
+}
+
+// NewVestingDelegationDecorator creates a new VestingDelegationDecorator
+func NewVestingDelegationDecorator(ak authante.AccountKeeper, sk anteutils.StakingKeeper) VestingDelegationDecorator {
+	return VestingDelegationDecorator{
+		ak: ak,
+		sk: sk,
+	}
+}
+
+// AnteHandle checks if the tx contains a MsgDelegate of a vesting account, including the ones
+// wrapped in authz MsgExec, and rejects it if the delegated amount exceeds the vested coins.
+func (vdd VestingDelegationDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (cosmos.Context, error) {
+	if err := vdd.validateMsgs(ctx, tx.GetMsgs(), 1); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs checks the msgs recursively as MsgExec's can wrap other MsgExecs,
+// up to the maxNestedMsgs threshold.
+func (vdd VestingDelegationDecorator) validateMsgs(ctx cosmos.Context, msgs []cosmos.Msg, nestedLvl int) error {
+	if nestedLvl >= maxNestedMsgs {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, fmt.Sprintf("found more nested msgs than permited. Limit is : %d", maxNestedMsgs))
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := vdd.validateMsgs(ctx, innerMsgs, nestedLvl+1); err != nil {
+				return err
+			}
+		case *stakingtypes.MsgDelegate:
+			if err := vdd.validateDelegation(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateDelegation checks if the delegator has enough vested coins for the delegation,
+// delegators which are not vesting accounts are skipped.
+func (vdd VestingDelegationDecorator) validateDelegation(ctx cosmos.Context, msg *stakingtypes.MsgDelegate) error {
+	delegator, err := cosmos.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid delegator address %s", msg.DelegatorAddress)
+	}
+
+	acc := vdd.ak.GetAccount(ctx, delegator)
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", delegator)
+	}
+
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	bondDenom := vdd.sk.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	vested := vestingAcc.GetVestedCoins(ctx.BlockTime()).AmountOf(bondDenom)
+	if vested.LT(msg.Amount.Amount) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"cannot delegate unvested coins. vested amount (%s%s) is smaller than delegation amount (%s)",
+			vested, bondDenom, msg.Amount,
+		)
+	}
+	return nil
+}
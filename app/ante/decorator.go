@@ -18,20 +18,22 @@ import (
 	evmante "github.com/artela-network/artela/app/ante/evm"
 	anteutils "github.com/artela-network/artela/app/ante/utils"
 	"github.com/artela-network/artela/app/interfaces"
+	"github.com/artela-network/artela/app/upgrades/v049rc9"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/txs"
 	evmmodule "github.com/artela-network/artela/x/evm/types"
-	// vestingtypes "github.com/artela-network/artela/x/vesting/types"
 )
 
 // AnteDecorators defines the list of module keepers required to run the Artela
 // AnteHandler decorators.
 type AnteDecorators struct {
-	Cdc                codec.BinaryCodec
-	AccountKeeper      evmmodule.AccountKeeper
-	BankKeeper         evmmodule.BankKeeper
-	DistributionKeeper anteutils.DistributionKeeper
-	IBCKeeper          *ibckeeper.Keeper
-	// StakingKeeper          vestingtypes.StakingKeeper
+	Cdc                    codec.BinaryCodec
+	AccountKeeper          evmmodule.AccountKeeper
+	BankKeeper             evmmodule.BankKeeper
+	DistributionKeeper     anteutils.DistributionKeeper
+	IBCKeeper              *ibckeeper.Keeper
+	StakingKeeper          anteutils.StakingKeeper
+	UpgradeKeeper          anteutils.UpgradeKeeper
 	FeeKeeper              interfaces.FeeKeeper
 	EvmKeeper              interfaces.EVMKeeper
 	FeegrantKeeper         ante.FeegrantKeeper
@@ -40,6 +42,10 @@ type AnteDecorators struct {
 	SigGasConsumer         func(meter cosmos.GasMeter, sig signing.SignatureV2, params authmodule.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           anteutils.TxFeeChecker
+	// ProposalTxs is the queue of the txs submitted by aspects to the proposal, nil if disabled
+	ProposalTxs *artelatypes.ProposalTxQueue
+	// Mempool is the app-side mempool accepting queued ethereum txs, nil if disabled
+	Mempool interfaces.Mempool
 }
 
 // Validate checks if the keepers are defined
@@ -56,9 +62,12 @@ func (options AnteDecorators) Validate() error {
 	if options.IBCKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "ibc keeper is required for AnteHandler")
 	}
-	// if options.StakingKeeper == nil {
-	//	return errorsmod.Wrap(errortypes.ErrLogic, "staking keeper is required for AnteHandler")
-	// }
+	if options.StakingKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "staking keeper is required for AnteHandler")
+	}
+	if options.UpgradeKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "upgrade keeper is required for AnteHandler")
+	}
 	if options.FeeKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "fee market keeper is required for AnteHandler")
 	}
@@ -90,13 +99,13 @@ func newEVMAnteHandler(app *baseapp.BaseApp, options AnteDecorators) cosmos.Ante
 		// Check eth effective gas price against the global MinGasPrice
 		evmante.NewEthMinGasPriceDecorator(options.FeeKeeper, options.EvmKeeper),
 		evmante.NewEthValidateBasicDecorator(options.EvmKeeper),
-		evmante.NewAspectRuntimeContextDecorator(app, options.EvmKeeper),
+		evmante.NewAspectRuntimeContextDecorator(app, options.EvmKeeper, options.ProposalTxs),
 		evmante.NewEthSigVerificationDecorator(app, options.EvmKeeper),
 		evmante.NewEthAccountVerificationDecorator(options.AccountKeeper, options.EvmKeeper),
 		evmante.NewCanTransferDecorator(options.EvmKeeper),
 		// evmante.NewEthVestingTransactionDecorator(options.AccountKeeper, options.BankKeeper, options.EvmKeeper),
 		evmante.NewEthGasConsumeDecorator(options.BankKeeper, options.DistributionKeeper, options.EvmKeeper, nil, options.MaxTxGasWanted),
-		evmante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper),
+		evmante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper, options.Mempool),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeKeeper),
 		// emit eth tx hash and index at the very last ante handler.
 		evmante.NewEthEmitEventDecorator(options.EvmKeeper),
@@ -118,8 +127,8 @@ func newCosmosAnteHandler(options AnteDecorators) cosmos.AnteHandler {
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		cosmosante.NewMinGasPriceDecorator(options.FeeKeeper, options.EvmKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		// cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
-		// cosmosante.NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc),
+		newDeductFeeDecorator(options),
+		newVestingDelegationDecorator(options),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -145,8 +154,8 @@ func newLegacyCosmosAnteHandlerEip712(options AnteDecorators) cosmos.AnteHandler
 		cosmosante.NewMinGasPriceDecorator(options.FeeKeeper, options.EvmKeeper),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		// cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
-		// cosmosante.NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc),
+		newDeductFeeDecorator(options),
+		newVestingDelegationDecorator(options),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -159,3 +168,17 @@ func newLegacyCosmosAnteHandlerEip712(options AnteDecorators) cosmos.AnteHandler
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeKeeper),
 	)
 }
+
+// newDeductFeeDecorator creates the fee deduction of the cosmos txs, enabled by the v049rc9 upgrade
+func newDeductFeeDecorator(options AnteDecorators) cosmos.AnteDecorator {
+	return cosmosante.NewUpgradeGateDecorator(options.UpgradeKeeper, v049rc9.UpgradeName,
+		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.DistributionKeeper, options.FeegrantKeeper, options.StakingKeeper, options.TxFeeChecker),
+	)
+}
+
+// newVestingDelegationDecorator creates the vesting delegation checks of the cosmos txs, enabled by the v049rc9 upgrade
+func newVestingDelegationDecorator(options AnteDecorators) cosmos.AnteDecorator {
+	return cosmosante.NewUpgradeGateDecorator(options.UpgradeKeeper, v049rc9.UpgradeName,
+		cosmosante.NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper),
+	)
+}
@@ -20,15 +20,18 @@ import (
 
 // CreateAspectRuntimeContextDecorator prepare the aspect runtime context
 type AspectRuntimeContextDecorator struct {
-	evmKeeper interfaces.EVMKeeper
-	app       *baseapp.BaseApp
+	evmKeeper   interfaces.EVMKeeper
+	app         *baseapp.BaseApp
+	proposalTxs *types.ProposalTxQueue
 }
 
-// NewAspectRuntimeContextDecorator creates a new AspectRuntimeContextDecorator
-func NewAspectRuntimeContextDecorator(app *baseapp.BaseApp, ek interfaces.EVMKeeper) AspectRuntimeContextDecorator {
+// NewAspectRuntimeContextDecorator creates a new AspectRuntimeContextDecorator,
+// proposalTxs can be nil if the aspects are not allowed to submit txs to the proposal.
+func NewAspectRuntimeContextDecorator(app *baseapp.BaseApp, ek interfaces.EVMKeeper, proposalTxs *types.ProposalTxQueue) AspectRuntimeContextDecorator {
 	return AspectRuntimeContextDecorator{
-		evmKeeper: ek,
-		app:       app,
+		evmKeeper:   ek,
+		app:         app,
+		proposalTxs: proposalTxs,
 	}
 }
 
@@ -56,7 +59,12 @@ func (aspd AspectRuntimeContextDecorator) AnteHandle(ctx cosmos.Context, tx cosm
 		}
 		ethTxContext := types.NewEthTxContext(msgEthTx.AsEthCallTransaction()).WithEVMConfig(evmConfig).WithStateDB(stateDB)
 		aspectCtx := types.NewAspectRuntimeContext()
-		protocol := provider.NewAspectProtocolProvider(aspectCtx.EthTxContext)
+		protocol := provider.NewAspectProtocolProvider(aspectCtx, aspd.evmKeeper)
+		if !ctx.IsCheckTx() && !simulate {
+			// the queue is only open while the block is executed, so the txs submitted
+			// while preparing or processing a proposal are dropped
+			protocol.WithProposalTxQueue(aspd.proposalTxs)
+		}
 		jitManager := inherent.NewManager(protocol)
 		aspectCtx.SetEthTxContext(ethTxContext, jitManager)
 
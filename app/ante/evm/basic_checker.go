@@ -328,13 +328,17 @@ func (ctd CanTransferDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, sim
 
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmmodule.AccountKeeper
+	ak      evmmodule.AccountKeeper
+	mempool interfaces.Mempool
 }
 
-// NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmmodule.AccountKeeper) EthIncrementSenderSequenceDecorator {
+// NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator,
+// the mempool is optional, if set, txs with future nonces are accepted on CheckTx. The nonces
+// below the account sequence are always rejected.
+func NewEthIncrementSenderSequenceDecorator(ak evmmodule.AccountKeeper, mempool interfaces.Mempool) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:      ak,
+		mempool: mempool,
 	}
 }
 
@@ -362,6 +366,11 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx cosmos.Context, t
 			)
 		}
 		nonce := acc.GetSequence()
+		if issd.mempool != nil && ctx.IsCheckTx() && !simulate && txData.GetNonce() > nonce {
+			// the tx with a future nonce is queued in the mempool until the nonce gap is filled
+			continue
+		}
+
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
 		if txData.GetNonce() != nonce {
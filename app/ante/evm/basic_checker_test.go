@@ -0,0 +1,98 @@
+package evm_test
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
+)
+
+type mockAccountKeeper struct {
+	evmmodule.AccountKeeper
+
+	accounts map[string]authtypes.AccountI
+}
+
+func (ak mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return ak.accounts[addr.String()]
+}
+
+func (ak mockAccountKeeper) SetAccount(_ sdk.Context, acc authtypes.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
+
+type mockMempool struct{}
+
+func (mockMempool) Contains(common.Address, uint64) bool { return true }
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func newEthTx(t *testing.T, from common.Address, nonce uint64) sdk.Tx {
+	to := common.HexToAddress("0x1")
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, To: &to, Gas: 21000})))
+	msg.From = from.Hex()
+	return mockTx{msgs: []sdk.Msg{msg}}
+}
+
+func TestEthIncrementSenderSequenceDecorator(t *testing.T) {
+	from := common.HexToAddress("0x2")
+	acc := authtypes.NewBaseAccountWithAddress(from.Bytes())
+	require.NoError(t, acc.SetSequence(5))
+	ak := mockAccountKeeper{accounts: map[string]authtypes.AccountI{acc.GetAddress().String(): acc}}
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+		WithIsCheckTx(true)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name     string
+		mempool  bool
+		recheck  bool
+		nonce    uint64
+		sequence uint64
+		err      error
+	}{
+		{"expected nonce", false, false, 5, 6, nil},
+		{"future nonce without mempool", false, false, 6, 5, errortypes.ErrInvalidSequence},
+		{"future nonce queued in mempool", true, false, 7, 5, nil},
+		{"expected nonce with mempool", true, false, 5, 6, nil},
+		{"past nonce with mempool", true, false, 4, 5, errortypes.ErrInvalidSequence},
+		{"past nonce on recheck", true, true, 4, 5, errortypes.ErrInvalidSequence},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, acc.SetSequence(5))
+
+			var decorator evmante.EthIncrementSenderSequenceDecorator
+			if tc.mempool {
+				decorator = evmante.NewEthIncrementSenderSequenceDecorator(ak, mockMempool{})
+			} else {
+				decorator = evmante.NewEthIncrementSenderSequenceDecorator(ak, nil)
+			}
+
+			_, err := decorator.AnteHandle(ctx.WithIsReCheckTx(tc.recheck), newEthTx(t, from, tc.nonce), false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.sequence, ak.accounts[acc.GetAddress().String()].GetSequence())
+		})
+	}
+}
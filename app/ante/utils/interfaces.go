@@ -23,3 +23,9 @@ type StakingKeeper interface {
 	BondDenom(ctx cosmos.Context) string
 	IterateDelegations(ctx cosmos.Context, delegator cosmos.AccAddress, fn func(index int64, delegation stakingmodule.DelegationI) (stop bool))
 }
+
+// UpgradeKeeper defines the exposed interface for using functionality of the upgrade keeper
+// in the context of the AnteHandler utils package.
+type UpgradeKeeper interface {
+	GetDoneHeight(ctx cosmos.Context, name string) int64
+}
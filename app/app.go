@@ -10,6 +10,7 @@ import (
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	errorsmod "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -22,11 +23,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -111,10 +115,18 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
 
+	"github.com/artela-network/artela/app/upgrades/v0410rc10"
 	"github.com/artela-network/artela/app/upgrades/v047rc7"
 	"github.com/artela-network/artela/app/upgrades/v048rc8"
+	"github.com/artela-network/artela/app/upgrades/v049rc9"
 	evmmodule "github.com/artela-network/artela/x/evm"
+	"github.com/artela-network/artela/x/evm/artela/handle"
+	artvmtypes "github.com/artela-network/artela/x/evm/artela/types"
 	evmmodulekeeper "github.com/artela-network/artela/x/evm/keeper"
+	"github.com/artela-network/artela/x/evm/precompile"
+	bankprecompile "github.com/artela-network/artela/x/evm/precompile/bank"
+	distrprecompile "github.com/artela-network/artela/x/evm/precompile/distribution"
+	stakingprecompile "github.com/artela-network/artela/x/evm/precompile/staking"
 	evmmoduletypes "github.com/artela-network/artela/x/evm/types"
 	feemodule "github.com/artela-network/artela/x/fee"
 	feemodulekeeper "github.com/artela-network/artela/x/fee/keeper"
@@ -124,13 +136,14 @@ import (
 
 	"github.com/artela-network/artela/app/ante"
 	ethante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/app/interfaces"
+	artelamempool "github.com/artela-network/artela/app/mempool"
 	appparams "github.com/artela-network/artela/app/params"
 	"github.com/artela-network/artela/app/post"
 	"github.com/artela-network/artela/common"
 	"github.com/artela-network/artela/docs"
 	srvflags "github.com/artela-network/artela/ethereum/server/flags"
 	artela "github.com/artela-network/artela/ethereum/types"
-	aspecttypes "github.com/artela-network/aspect-core/types"
 
 	// do not remove this, this will register the native evm tracers
 	_ "github.com/artela-network/artela-evm/tracers/native"
@@ -277,6 +290,11 @@ type Artela struct {
 	FeeKeeper *feemodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
+	// proposalTxs holds the txs submitted by aspects to the proposal, nil if the proposal handler is disabled
+	proposalTxs *artvmtypes.ProposalTxQueue
+	// evmMempool is the app-side mempool queueing the txs by sender and nonce, nil if disabled
+	evmMempool *artelamempool.PriorityNonceMempool
+
 	// mm is the module manager
 	mm *module.Manager
 
@@ -303,6 +321,29 @@ func NewArtela(
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 	txConfig := encodingConfig.TxConfig
 
+	// the app-side mempool is opt-in, its size is limited by max-txs, 0 means unbounded,
+	// it must be set before the BaseApp is created to be used by the default proposal handler.
+	var app *Artela
+	var evmMempool *artelamempool.PriorityNonceMempool
+	if cast.ToBool(appOpts.Get(srvflags.EVMEnableMempool)) {
+		maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+		if maxTxs < 0 {
+			maxTxs = artelamempool.DefaultMaxTx
+		}
+		evmMempool = artelamempool.NewPriorityNonceMempool(
+			maxTxs,
+			cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+			func(ctx cosmos.Context, addr cosmos.AccAddress) uint64 {
+				acc := app.AccountKeeper.GetAccount(ctx, addr)
+				if acc == nil {
+					return 0
+				}
+				return acc.GetSequence()
+			},
+		)
+		baseAppOptions = append(baseAppOptions, baseapp.SetMempool(evmMempool))
+	}
+
 	bApp := baseapp.NewBaseApp(
 		Name,
 		logger,
@@ -328,7 +369,7 @@ func NewArtela(
 	tkeys := cosmos.NewTransientStoreKeys(paramsmodule.TStoreKey, evmmoduletypes.TransientKey, feemoduletypes.TransientKey)
 	memKeys := cosmos.NewMemoryStoreKeys(capabilitymodule.MemStoreKey)
 
-	app := &Artela{
+	app = &Artela{
 		BaseApp:           bApp,
 		cdc:               cdc,
 		appCodec:          appCodec,
@@ -338,6 +379,7 @@ func NewArtela(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		evmMempool:        evmMempool,
 	}
 
 	app.ParamsKeeper = initParamsKeeper(
@@ -359,7 +401,8 @@ func NewArtela(
 	)
 
 	// set the runner cache capacity of aspect-runtime
-	aspecttypes.InitRuntimePool(context.Background(), common.WrapLogger(app.Logger()), cast.ToInt32(appOpts.Get(srvflags.ApplyPoolSize)), cast.ToInt32(appOpts.Get(srvflags.QueryPoolSize)))
+	applyPoolSize, queryPoolSize := cast.ToInt32(appOpts.Get(srvflags.ApplyPoolSize)), cast.ToInt32(appOpts.Get(srvflags.QueryPoolSize))
+	artvmtypes.InitRunnerPools(context.Background(), common.WrapLogger(app.Logger()), applyPoolSize, queryPoolSize)
 
 	// grant capabilities for the ibc and ibc-transfer modules
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
@@ -561,6 +604,13 @@ func NewArtela(
 	)
 	evmModule := evmmodule.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmmoduletypes.ModuleName))
 
+	// the stateful precompiled contracts are enabled by the v0410rc10 upgrade and the evm params
+	app.EvmKeeper.WithPrecompiles(precompile.NewPrecompiles(app.UpgradeKeeper, v0410rc10.UpgradeName,
+		bankprecompile.NewContract(app.BankKeeper),
+		stakingprecompile.NewContract(app.StakingKeeper),
+		distrprecompile.NewContract(app.DistrKeeper, app.StakingKeeper),
+	))
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	/**** IBC Routing ****/
@@ -750,6 +800,11 @@ func NewArtela(
 
 	// initialize BaseApp
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	enableProposalHandler := cast.ToBool(appOpts.Get(srvflags.EnableProposalHandler))
+	if enableProposalHandler {
+		// the txs submitted by aspects are placed in the next proposal prepared by this node
+		app.proposalTxs = artvmtypes.NewProposalTxQueue(artvmtypes.DefaultProposalTxQueueSize)
+	}
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -757,10 +812,17 @@ func NewArtela(
 	// init Aspect
 	app.setPostHandler()
 
-	// // aspect add ProposalHandler
-	// aspectProposalHandler := handle.NewArtelaProposalHandler(bApp.GetMemPool(), bApp)
-	// bApp.SetPrepareProposal(aspectProposalHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(aspectProposalHandler.ProcessProposalHandler())
+	// aspect add ProposalHandler
+	if enableProposalHandler {
+		// the txs are selected from the app-side mempool if enabled, otherwise the txs requested from CometBFT are ordered
+		var proposalMempool mempool.Mempool = mempool.NoOpMempool{}
+		if app.evmMempool != nil {
+			proposalMempool = app.evmMempool
+		}
+		proposalHandler := handle.NewArtelaProposalHandler(proposalMempool, bApp, txConfig, app.FeeKeeper, app.EvmKeeper, app.proposalTxs)
+		bApp.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+		bApp.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	}
 	// setupUpgradeHandlers should be called before `LoadLatestVersion()`
 	// because StoreLoad is sealed after that
 	// app upgrade
@@ -793,10 +855,12 @@ func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
+		StakingKeeper:          app.StakingKeeper,
+		UpgradeKeeper:          app.UpgradeKeeper,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
-
-		// TODO StakingKeeper:          app.StakingKeeper,
-		IBCKeeper: app.IBCKeeper,
+		ProposalTxs:            app.proposalTxs,
+		Mempool:                app.anteMempool(),
+		IBCKeeper:              app.IBCKeeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -806,6 +870,37 @@ func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 	app.SetAnteHandler(ante.NewAnteHandler(app.BaseApp, options))
 }
 
+// anteMempool returns the app-side mempool used by the AnteHandler, nil if disabled
+func (app *Artela) anteMempool() interfaces.Mempool {
+	if app.evmMempool == nil {
+		return nil
+	}
+	return app.evmMempool
+}
+
+// CheckTx implements abci.Application. The txs replaced or evicted in the app-side mempool
+// are rejected on recheck, so that CometBFT drops them from its mempool and its cache, and
+// they can be submitted again.
+func (app *Artela) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if app.evmMempool != nil && req.Type == abci.CheckTxType_Recheck {
+		tx, err := app.txConfig.TxDecoder()(req.Tx)
+		if err == nil && !app.evmMempool.Has(tx) {
+			return sdkerrors.ResponseCheckTxWithEvents(
+				errorsmod.Wrap(mempool.ErrTxNotFound, "tx is replaced or evicted from the app-side mempool"), 0, 0, nil, false,
+			)
+		}
+	}
+	return app.BaseApp.CheckTx(req)
+}
+
+// EVMMempool returns the app-side mempool exposed to the json-rpc backend, nil if disabled
+func (app *Artela) EVMMempool() artela.EVMMempool {
+	if app.evmMempool == nil {
+		return nil
+	}
+	return app.evmMempool
+}
+
 func (app *Artela) setPostHandler() {
 	options := post.PostDecorators{
 		EvmKeeper: app.EvmKeeper,
@@ -823,11 +918,18 @@ func (app *Artela) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *Artela) BeginBlocker(ctx cosmos.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.proposalTxs != nil {
+		// only the txs submitted by aspects while executing the block are queued for the proposal
+		app.proposalTxs.Open()
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker application updates every end block
 func (app *Artela) EndBlocker(ctx cosmos.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	if app.proposalTxs != nil {
+		defer app.proposalTxs.Close()
+	}
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -838,7 +940,18 @@ func (app *Artela) InitChainer(ctx cosmos.Context, req abci.RequestInitChain) ab
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+
+	// the chains started from genesis run with the upgrades gating the AnteHandler and the
+	// precompiled contracts applied, they are done at the initial height as the done height
+	// of an upgrade must be positive
+	initialHeight := req.InitialHeight
+	if initialHeight < 1 {
+		initialHeight = 1
+	}
+	app.UpgradeKeeper.ApplyUpgrade(ctx.WithBlockHeight(initialHeight), upgrademodule.Plan{Name: v049rc9.UpgradeName, Height: initialHeight})
+	app.UpgradeKeeper.ApplyUpgrade(ctx.WithBlockHeight(initialHeight), upgrademodule.Plan{Name: v0410rc10.UpgradeName, Height: initialHeight})
+	return res
 }
 
 // Configurator get app configurator
@@ -1015,6 +1128,22 @@ func (app *Artela) setupUpgradeHandlers() {
 		),
 	)
 
+	// v0.4.9-rc9 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v049rc9.UpgradeName,
+		v049rc9.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
+	// v0.4.10-rc10 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v0410rc10.UpgradeName,
+		v0410rc10.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1034,6 +1163,10 @@ func (app *Artela) setupUpgradeHandlers() {
 		// no store upgrades
 	case v048rc8.UpgradeName:
 		// no store upgrades in v048rc8
+	case v049rc9.UpgradeName:
+		// no store upgrades in v049rc9
+	case v0410rc10.UpgradeName:
+		// no store upgrades in v0410rc10
 	default:
 		// no-op
 	}
@@ -13,6 +13,7 @@ import (
 
 	artvmtype "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
 	evmtypes "github.com/artela-network/artela/x/evm/txs/support"
 	feemodule "github.com/artela-network/artela/x/fee/types"
 )
@@ -25,6 +26,8 @@ type EVMKeeper interface {
 	NewEVM(ctx cosmos.Context, msg *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx cosmos.Context, fees cosmos.Coins, from common.Address) error
 	GetBalance(ctx cosmos.Context, addr common.Address) *big.Int
+	GetNonce(ctx cosmos.Context, addr common.Address) uint64
+	EstimateGasWithConfig(ctx cosmos.Context, args txs.TransactionArgs, gasCap uint64, cfg *states.EVMConfig, blockCtx *artvmtype.EthBlockContext) (uint64, error)
 	ResetTransientGasUsed(ctx cosmos.Context)
 	GetTxIndexTransient(ctx cosmos.Context) uint64
 	GetParams(ctx cosmos.Context) evmtypes.Params
@@ -52,3 +55,8 @@ type DynamicFeeEVMKeeper interface {
 type ProtoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
+
+// Mempool is the app-side mempool used on the AnteHandler to accept queued txs
+type Mempool interface {
+	Contains(sender common.Address, nonce uint64) bool
+}
@@ -0,0 +1,455 @@
+package mempool
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+const (
+	// DefaultPriceBump is the default minimum price bump percentage to replace an already queued tx.
+	DefaultPriceBump = 10
+	// DefaultMaxTx is the default max number of txs kept in the mempool.
+	DefaultMaxTx = 5000
+)
+
+var (
+	_ sdkmempool.Mempool     = (*PriorityNonceMempool)(nil)
+	_ artelatypes.EVMMempool = (*PriorityNonceMempool)(nil)
+
+	// ErrAlreadyKnown is returned if the tx is already kept in the mempool.
+	ErrAlreadyKnown = errors.New("already known")
+	// ErrReplaceUnderpriced is returned if a tx is attempted to be replaced
+	// with a different one without the required price bump.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrUnderpriced is returned if the mempool is full and the priority of the tx
+	// is not higher than the lowest one in the mempool.
+	ErrUnderpriced = errors.New("transaction underpriced")
+)
+
+// NonceGetter returns the nonce of the account in the state of the context.
+type NonceGetter func(ctx sdk.Context, addr sdk.AccAddress) uint64
+
+// mempoolTx is a tx kept in the mempool.
+type mempoolTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	// id identifies the tx among the txs with the same sender and nonce, it's the hash of
+	// an ethereum tx, and the signatures of a cosmos tx
+	id []byte
+	// ethTx is nil if the tx is not an ethereum tx
+	ethTx *ethtypes.Transaction
+	// seq is the insertion order, it breaks the ties of the priorities
+	seq uint64
+}
+
+// PriorityNonceMempool is an app-side mempool keeping the txs in per-sender queues
+// ordered by nonce, the nonce of an ethereum tx is the nonce of the ethereum tx
+// and the nonce of a cosmos tx is the sequence of the first signature. Unlike the
+// CometBFT mempool, the ethereum txs with future nonces are kept queued until they
+// become executable, and an ethereum tx can be replaced by another one with the same
+// nonce paying at least priceBump percent more. Once the mempool is full, the tx with
+// the lowest priority at the tail of a queue is evicted for a tx with a higher priority.
+//
+// The replaced and the evicted txs are still kept by the CometBFT mempool, they are
+// rejected on recheck by the app (see Has), so that CometBFT drops them from its mempool
+// and cache, and the txs can be submitted again.
+type PriorityNonceMempool struct {
+	mu        sync.RWMutex
+	maxTx     int
+	priceBump uint64
+	nonceOf   NonceGetter
+
+	senders map[string][]*mempoolTx
+	count   int
+	seq     uint64
+}
+
+// NewPriorityNonceMempool creates the mempool, maxTx 0 means unbounded.
+func NewPriorityNonceMempool(maxTx int, priceBump uint64, nonceOf NonceGetter) *PriorityNonceMempool {
+	return &PriorityNonceMempool{
+		maxTx:     maxTx,
+		priceBump: priceBump,
+		nonceOf:   nonceOf,
+		senders:   make(map[string][]*mempoolTx),
+	}
+}
+
+// Insert implements sdkmempool.Mempool, the priority of the tx is the one
+// set to the context by the AnteHandler.
+func (mp *PriorityNonceMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtx, err := newMempoolTx(tx, ctx.Priority())
+	if err != nil {
+		return err
+	}
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	queue := mp.senders[mtx.sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= mtx.nonce })
+	if i < len(queue) && queue[i].nonce == mtx.nonce {
+		// replace the queued tx with the same nonce
+		if err := mp.canReplace(queue[i], mtx); err != nil {
+			return err
+		}
+		mp.seq++
+		mtx.seq = mp.seq
+		queue[i] = mtx
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		if !mp.evict(mtx) {
+			return ErrUnderpriced
+		}
+		// the queue of the sender may be changed by the eviction
+		queue = mp.senders[mtx.sender]
+		i = sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= mtx.nonce })
+	}
+
+	mp.seq++
+	mtx.seq = mp.seq
+	queue = append(queue, nil)
+	copy(queue[i+1:], queue[i:])
+	queue[i] = mtx
+	mp.senders[mtx.sender] = queue
+	mp.count++
+	return nil
+}
+
+// Select implements sdkmempool.Mempool, it returns the executable txs ordered by priority,
+// while the txs of a sender are kept in nonce order. The txs with nonces lower than the
+// account nonce of the context are stale, they are pruned, and the txs after a nonce gap
+// are not executable yet, they are skipped.
+func (mp *PriorityNonceMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	heads := make(txsByPriority, 0, len(mp.senders))
+	executables := make(map[string][]*mempoolTx, len(mp.senders))
+	for sender, queue := range mp.senders {
+		nonce := mp.nonceOf(ctx, sdk.MustAccAddressFromBech32(sender))
+
+		stale := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+		if stale > 0 {
+			queue = queue[stale:]
+			mp.count -= stale
+			if len(queue) == 0 {
+				delete(mp.senders, sender)
+				continue
+			}
+			mp.senders[sender] = queue
+		}
+
+		executable := 0
+		for executable < len(queue) && queue[executable].nonce == nonce+uint64(executable) {
+			executable++
+		}
+		if executable == 0 {
+			continue
+		}
+		heads = append(heads, queue[0])
+		executables[sender] = queue[1:executable]
+	}
+	heap.Init(&heads)
+
+	var selected []sdk.Tx
+	for len(heads) > 0 {
+		head := heads[0]
+		selected = append(selected, head.tx)
+		if rest := executables[head.sender]; len(rest) > 0 {
+			heads[0], executables[head.sender] = rest[0], rest[1:]
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+	return &iterator{txs: selected}
+}
+
+// CountTx implements sdkmempool.Mempool
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	return mp.count
+}
+
+// Remove implements sdkmempool.Mempool, the tx is removed only if it's the one kept
+// in the mempool, not a tx with the same nonce replaced already.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	mtx, err := newMempoolTx(tx, 0)
+	if err != nil {
+		return err
+	}
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	i, ok := mp.indexOf(mtx)
+	if !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeAt(mtx.sender, i)
+	return nil
+}
+
+// Has returns true if the tx is kept in the mempool, false if it has been replaced,
+// evicted or removed.
+func (mp *PriorityNonceMempool) Has(tx sdk.Tx) bool {
+	mtx, err := newMempoolTx(tx, 0)
+	if err != nil {
+		return false
+	}
+
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+
+	_, ok := mp.indexOf(mtx)
+	return ok
+}
+
+// Contains returns true if a tx of the sender with the nonce is kept in the mempool.
+func (mp *PriorityNonceMempool) Contains(sender common.Address, nonce uint64) bool {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+
+	queue := mp.senders[sdk.AccAddress(sender.Bytes()).String()]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+	return i < len(queue) && queue[i].nonce == nonce
+}
+
+// PendingNonce implements artelatypes.EVMMempool, it returns the next nonce of the sender,
+// taking the executable txs in the mempool into account.
+func (mp *PriorityNonceMempool) PendingNonce(sender common.Address, stateNonce uint64) uint64 {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+
+	nonce := stateNonce
+	for _, mtx := range mp.senders[sdk.AccAddress(sender.Bytes()).String()] {
+		if mtx.nonce < nonce {
+			continue
+		}
+		if mtx.nonce != nonce {
+			break
+		}
+		nonce++
+	}
+	return nonce
+}
+
+// EthTxsBySender implements artelatypes.EVMMempool, it returns the nonce sorted ethereum txs
+// of every sender in the mempool.
+func (mp *PriorityNonceMempool) EthTxsBySender() map[common.Address]ethtypes.Transactions {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+
+	result := make(map[common.Address]ethtypes.Transactions)
+	for sender, queue := range mp.senders {
+		addr := common.BytesToAddress(sdk.MustAccAddressFromBech32(sender))
+		for _, mtx := range queue {
+			if mtx.ethTx != nil {
+				result[addr] = append(result[addr], mtx.ethTx)
+			}
+		}
+	}
+	return result
+}
+
+// canReplace checks if the queued tx can be replaced by the new one. Ethereum txs
+// must bump both the fee cap and the tip cap by priceBump percent, and cosmos txs
+// must have a priority higher by priceBump percent.
+func (mp *PriorityNonceMempool) canReplace(old, replacement *mempoolTx) error {
+	if bytes.Equal(old.id, replacement.id) {
+		return ErrAlreadyKnown
+	}
+	if old.ethTx != nil && replacement.ethTx != nil {
+		if replacement.ethTx.GasFeeCapIntCmp(mp.bumped(old.ethTx.GasFeeCap())) < 0 ||
+			replacement.ethTx.GasTipCapIntCmp(mp.bumped(old.ethTx.GasTipCap())) < 0 {
+			return ErrReplaceUnderpriced
+		}
+		return nil
+	}
+
+	if replacement.priority < mp.bumped(big.NewInt(old.priority)).Int64() {
+		return ErrReplaceUnderpriced
+	}
+	return nil
+}
+
+// bumped returns the price bumped by priceBump percent.
+func (mp *PriorityNonceMempool) bumped(price *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+mp.priceBump))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// evict removes the tx with the lowest priority at the tail of a sender queue, so that
+// no nonce gap is created. It returns false if no tx has a priority lower than the new one.
+func (mp *PriorityNonceMempool) evict(mtx *mempoolTx) bool {
+	var (
+		victim   string
+		priority = mtx.priority
+	)
+	for sender, queue := range mp.senders {
+		tail := queue[len(queue)-1]
+		if sender == mtx.sender && tail.nonce < mtx.nonce {
+			// the new tx would be queued after the tail
+			continue
+		}
+		if tail.priority < priority {
+			victim, priority = sender, tail.priority
+		}
+	}
+	if victim == "" {
+		return false
+	}
+
+	mp.removeAt(victim, len(mp.senders[victim])-1)
+	return true
+}
+
+// indexOf returns the index of the tx in the queue of its sender, the tx is not found
+// if another tx with the same nonce is kept in the queue.
+func (mp *PriorityNonceMempool) indexOf(mtx *mempoolTx) (int, bool) {
+	queue := mp.senders[mtx.sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= mtx.nonce })
+	if i == len(queue) || queue[i].nonce != mtx.nonce || !bytes.Equal(queue[i].id, mtx.id) {
+		return 0, false
+	}
+	return i, true
+}
+
+// removeAt removes the tx at the index of the sender queue.
+func (mp *PriorityNonceMempool) removeAt(sender string, i int) {
+	queue := mp.senders[sender]
+	queue = append(queue[:i], queue[i+1:]...)
+	if len(queue) == 0 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = queue
+	}
+	mp.count--
+}
+
+// newMempoolTx parses the sender and the nonce of the tx.
+func newMempoolTx(tx sdk.Tx, priority int64) (*mempoolTx, error) {
+	mtx := &mempoolTx{tx: tx, priority: priority}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("tx has no message")
+	}
+
+	if msg, ok := msgs[0].(*txs.MsgEthereumTx); ok {
+		ethTx := msg.AsTransaction()
+		sender := common.HexToAddress(msg.From)
+		if msg.From == "" {
+			var err error
+			if sender, err = ethtypes.Sender(ethtypes.LatestSignerForChainID(ethTx.ChainId()), ethTx); err != nil {
+				return nil, fmt.Errorf("failed to recover the sender of tx %s: %w", ethTx.Hash().Hex(), err)
+			}
+		}
+		mtx.ethTx = ethTx
+		mtx.id = ethTx.Hash().Bytes()
+		mtx.sender = sdk.AccAddress(sender.Bytes()).String()
+		mtx.nonce = ethTx.Nonce()
+		return mtx, nil
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("tx %T doesn't implement %T", tx, (*authsigning.SigVerifiableTx)(nil))
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) == 0 {
+		return nil, errors.New("tx has no signature")
+	}
+	mtx.sender = sdk.AccAddress(sigs[0].PubKey.Address()).String()
+	mtx.nonce = sigs[0].Sequence
+	for _, sig := range sigs {
+		mtx.id = appendSignature(mtx.id, sig.Data)
+	}
+	return mtx, nil
+}
+
+// appendSignature appends the raw signatures of the signature data to the bytes.
+func appendSignature(bz []byte, data signing.SignatureData) []byte {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return append(bz, data.Signature...)
+	case *signing.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			bz = appendSignature(bz, sig)
+		}
+	}
+	return bz
+}
+
+// txsByPriority implements the heap interface of the txs, ordered by priority then by insertion.
+type txsByPriority []*mempoolTx
+
+func (s txsByPriority) Len() int { return len(s) }
+func (s txsByPriority) Less(i, j int) bool {
+	if s[i].priority == s[j].priority {
+		return s[i].seq < s[j].seq
+	}
+	return s[i].priority > s[j].priority
+}
+func (s txsByPriority) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txsByPriority) Push(x interface{}) {
+	*s = append(*s, x.(*mempoolTx))
+}
+
+func (s *txsByPriority) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*s = old[0 : n-1]
+	return x
+}
+
+// iterator iterates the txs selected from the mempool.
+type iterator struct {
+	txs []sdk.Tx
+}
+
+func (it *iterator) Next() sdkmempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+	return &iterator{txs: it.txs[1:]}
+}
+
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
@@ -0,0 +1,202 @@
+package mempool_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/app/mempool"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+type mempoolTestSuite struct {
+	txConfig client.TxConfig
+	keys     []*ecdsa.PrivateKey
+	nonces   map[string]uint64
+}
+
+func newMempoolTestSuite(t *testing.T) *mempoolTestSuite {
+	s := &mempoolTestSuite{
+		txConfig: app.MakeConfig(app.ModuleBasics).TxConfig,
+		nonces:   make(map[string]uint64),
+	}
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		s.keys = append(s.keys, key)
+	}
+	return s
+}
+
+func (s *mempoolTestSuite) newMempool(maxTx int) *mempool.PriorityNonceMempool {
+	return mempool.NewPriorityNonceMempool(maxTx, mempool.DefaultPriceBump, func(_ sdk.Context, addr sdk.AccAddress) uint64 {
+		return s.nonces[addr.String()]
+	})
+}
+
+func (s *mempoolTestSuite) sender(i int) common.Address {
+	return crypto.PubkeyToAddress(s.keys[i].PublicKey)
+}
+
+// ethTx builds the cosmos tx of an ethereum tx of the sender.
+func (s *mempoolTestSuite) ethTx(t *testing.T, sender int, nonce uint64, gasPrice int64) sdk.Tx {
+	to := common.BigToAddress(big.NewInt(1))
+	ethTx, err := ethtypes.SignNewTx(s.keys[sender], ethtypes.LatestSignerForChainID(big.NewInt(11822)), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Gas:      21000,
+		GasPrice: big.NewInt(gasPrice),
+	})
+	require.NoError(t, err)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	tx, err := msg.BuildTx(s.txConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	return tx
+}
+
+// cosmosTx builds a cosmos tx signed by the key with the sequence, the signature is
+// only used to tell apart the txs with the same sequence.
+func (s *mempoolTestSuite) cosmosTx(t *testing.T, key *secp256k1.PrivKey, sequence uint64, signature byte) sdk.Tx {
+	from := sdk.AccAddress(key.PubKey().Address())
+	builder := s.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("aart", 1)))))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte{signature}},
+		Sequence: sequence,
+	}))
+	return builder.GetTx()
+}
+
+func withPriority(priority int64) context.Context {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger()).WithPriority(priority)
+	return sdk.WrapSDKContext(ctx)
+}
+
+func selectTxs(mp *mempool.PriorityNonceMempool) []sdk.Tx {
+	var selected []sdk.Tx
+	for it := mp.Select(withPriority(0), nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	return selected
+}
+
+func TestPriorityNonceMempoolInsertAndSelect(t *testing.T) {
+	s := newMempoolTestSuite(t)
+	mp := s.newMempool(0)
+
+	a0, a2 := s.ethTx(t, 0, 0, 10), s.ethTx(t, 0, 2, 10)
+	b0, b1 := s.ethTx(t, 1, 0, 20), s.ethTx(t, 1, 1, 5)
+	for _, tx := range []sdk.Tx{a0, a2, b0, b1} {
+		require.NoError(t, mp.Insert(withPriority(txPriority(t, tx)), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.ErrorIs(t, mp.Insert(withPriority(10), a0), mempool.ErrAlreadyKnown)
+
+	// the tx after the nonce gap is not executable yet
+	require.Equal(t, uint64(1), mp.PendingNonce(s.sender(0), 0))
+	require.Equal(t, uint64(2), mp.PendingNonce(s.sender(1), 0))
+	require.Equal(t, uint64(5), mp.PendingNonce(s.sender(1), 5))
+	require.True(t, mp.Contains(s.sender(0), 2))
+	require.False(t, mp.Contains(s.sender(0), 1))
+	require.Equal(t, []sdk.Tx{b0, a0, b1}, selectTxs(mp))
+
+	a1 := s.ethTx(t, 0, 1, 10)
+	require.NoError(t, mp.Insert(withPriority(10), a1))
+	require.Equal(t, uint64(3), mp.PendingNonce(s.sender(0), 0))
+	require.Equal(t, []sdk.Tx{b0, a0, a1, a2, b1}, selectTxs(mp))
+
+	// the txs with nonces lower than the account nonce are pruned
+	s.nonces[sdk.AccAddress(s.sender(0).Bytes()).String()] = 2
+	require.Equal(t, []sdk.Tx{b0, a2, b1}, selectTxs(mp))
+	require.Equal(t, 3, mp.CountTx())
+	require.False(t, mp.Has(a0))
+	require.ErrorIs(t, mp.Remove(a1), sdkmempool.ErrTxNotFound)
+
+	require.NoError(t, mp.Remove(b0))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{a2}, selectTxs(mp))
+}
+
+func TestPriorityNonceMempoolReplace(t *testing.T) {
+	s := newMempoolTestSuite(t)
+	mp := s.newMempool(0)
+
+	old := s.ethTx(t, 0, 0, 100)
+	require.NoError(t, mp.Insert(withPriority(100), old))
+
+	underpriced := s.ethTx(t, 0, 0, 109)
+	require.ErrorIs(t, mp.Insert(withPriority(109), underpriced), mempool.ErrReplaceUnderpriced)
+	require.True(t, mp.Has(old))
+
+	replacement := s.ethTx(t, 0, 0, 110)
+	require.NoError(t, mp.Insert(withPriority(110), replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.Has(old))
+	require.True(t, mp.Has(replacement))
+
+	// the replaced tx cannot remove its replacement
+	require.ErrorIs(t, mp.Remove(old), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+
+	// same for the cosmos txs with the same sequence
+	key := secp256k1.GenPrivKey()
+	cosmosOld, cosmosReplacement := s.cosmosTx(t, key, 0, 1), s.cosmosTx(t, key, 0, 2)
+	require.NoError(t, mp.Insert(withPriority(100), cosmosOld))
+	require.ErrorIs(t, mp.Insert(withPriority(100), cosmosOld), mempool.ErrAlreadyKnown)
+	require.ErrorIs(t, mp.Insert(withPriority(105), cosmosReplacement), mempool.ErrReplaceUnderpriced)
+	require.NoError(t, mp.Insert(withPriority(110), cosmosReplacement))
+	require.False(t, mp.Has(cosmosOld))
+	require.ErrorIs(t, mp.Remove(cosmosOld), sdkmempool.ErrTxNotFound)
+	require.True(t, mp.Has(cosmosReplacement))
+	require.NoError(t, mp.Remove(cosmosReplacement))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestPriorityNonceMempoolEvict(t *testing.T) {
+	s := newMempoolTestSuite(t)
+	mp := s.newMempool(3)
+
+	a0, a1 := s.ethTx(t, 0, 0, 30), s.ethTx(t, 0, 1, 10)
+	b0 := s.ethTx(t, 1, 0, 20)
+	for _, tx := range []sdk.Tx{a0, a1, b0} {
+		require.NoError(t, mp.Insert(withPriority(txPriority(t, tx)), tx))
+	}
+
+	// the tx is not evicted for a tx with a lower or equal priority
+	c0 := s.ethTx(t, 2, 0, 10)
+	require.ErrorIs(t, mp.Insert(withPriority(10), c0), mempool.ErrUnderpriced)
+
+	// the tail with the lowest priority is evicted
+	c0 = s.ethTx(t, 2, 0, 40)
+	require.NoError(t, mp.Insert(withPriority(40), c0))
+	require.Equal(t, 3, mp.CountTx())
+	require.False(t, mp.Has(a1))
+	require.True(t, mp.Has(a0))
+	require.Equal(t, uint64(1), mp.PendingNonce(s.sender(0), 0))
+	require.Equal(t, []sdk.Tx{c0, a0, b0}, selectTxs(mp))
+}
+
+// txPriority returns the gas price of the ethereum tx as its priority.
+func txPriority(t *testing.T, tx sdk.Tx) int64 {
+	msg, ok := tx.GetMsgs()[0].(*txs.MsgEthereumTx)
+	require.True(t, ok)
+	return msg.AsTransaction().GasPrice().Int64()
+}
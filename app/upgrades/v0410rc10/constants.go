@@ -0,0 +1,5 @@
+package v0410rc10
+
+const (
+	UpgradeName = "v0410rc10"
+)
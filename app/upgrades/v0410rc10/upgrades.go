@@ -0,0 +1,19 @@
+package v0410rc10
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v0410rc10, which enables the stateful
+// precompiled contracts of the evm listed in the evm params.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// Leave modules are as-is to avoid running InitGenesis.
+		logger.Debug("v0410rc10 running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
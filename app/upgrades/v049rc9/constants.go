@@ -0,0 +1,5 @@
+package v049rc9
+
+const (
+	UpgradeName = "v049rc9"
+)
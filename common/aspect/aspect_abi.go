@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/artela-network/aspect-core/djpm/contract"
 	"github.com/artela-network/aspect-core/types"
 )
 
@@ -104,6 +105,100 @@ var methods = map[string]abi.Method{
 	}),
 }
 
+var events = map[string]abi.Event{
+	"AspectDeployed": abi.NewEvent("AspectDeployed", "AspectDeployed", false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "joinPoints", Type: Uint256, Indexed: false},
+	}),
+	"AspectUpgraded": abi.NewEvent("AspectUpgraded", "AspectUpgraded", false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "joinPoints", Type: Uint256, Indexed: false},
+	}),
+	"AspectBound": abi.NewEvent("AspectBound", "AspectBound", false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "priority", Type: Int8, Indexed: false},
+	}),
+	"AspectUnbound": abi.NewEvent("AspectUnbound", "AspectUnbound", false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+	}),
+	"AspectVersionChanged": abi.NewEvent("AspectVersionChanged", "AspectVersionChanged", false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+	}),
+}
+
+// GetEvent returns the abi of the aspect system contract event with the given name
+func GetEvent(name string) (abi.Event, bool) {
+	event, ok := events[name]
+	return event, ok
+}
+
+// PackEvent encodes the aspect system contract event into log topics and data,
+// args should be given in the same order as declared in the event abi.
+func PackEvent(name string, args ...interface{}) ([]common.Hash, []byte, error) {
+	event, ok := events[name]
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "event %s not found", name)
+	}
+	if len(args) != len(event.Inputs) {
+		return nil, nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "event %s expects %d args, got %d", name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var nonIndexed []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return nil, nil, err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return topics, data, nil
+}
+
+// SystemContractAddress is the address of the aspect system contract defined by aspect-core
+var SystemContractAddress = common.HexToAddress(contract.ARTELA_FROM_ADDR)
+
+// PackMethod encodes the call data of the aspect system contract method with the given name
+func PackMethod(name string, args ...interface{}) ([]byte, error) {
+	method, ok := methods[name]
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "method %s not found", name)
+	}
+
+	data, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.CopyBytes(method.ID), data...), nil
+}
+
+// UnpackMethodOutput decodes the return data of the aspect system contract method with the given name
+func UnpackMethodOutput(name string, data []byte) ([]interface{}, error) {
+	method, ok := methods[name]
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "method %s not found", name)
+	}
+	return method.Outputs.Unpack(data)
+}
+
 var methodsLookup = AbiMap()
 
 var AbiMap = func() map[string]string {
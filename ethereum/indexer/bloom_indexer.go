@@ -0,0 +1,132 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const (
+	KeyPrefixBloomBits = 1
+	KeyPrefixSections  = 2
+
+	// BloomBitsKeyLength is the length of bloom-bits key
+	BloomBitsKeyLength = 1 + 2 + 8
+)
+
+var _ artelatypes.EVMBloomIndexer = &BloomIndexer{}
+
+// BloomIndexer implements the bloombits index on a KV db, the blooms of every
+// section of blocks are rotated into 2048 bit vectors, one for every bloom bit,
+// so that a bloombits.Matcher only loads the vectors of the bits it is looking for.
+type BloomIndexer struct {
+	db          dbm.DB
+	logger      log.Logger
+	sectionSize uint64
+}
+
+// NewBloomIndexer creates the BloomIndexer with the same section size as go-ethereum
+func NewBloomIndexer(db dbm.DB, logger log.Logger) *BloomIndexer {
+	return &BloomIndexer{db, logger, params.BloomBitsBlocks}
+}
+
+// SectionSize returns the number of blocks of a section
+func (bi *BloomIndexer) SectionSize() uint64 {
+	return bi.sectionSize
+}
+
+// Sections returns the number of fully indexed sections
+func (bi *BloomIndexer) Sections() (uint64, error) {
+	bz, err := bi.db.Get([]byte{KeyPrefixSections})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "Sections")
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// IndexSection rotates the blooms of a section into bit vectors and stores them compressed,
+// together with the number of indexed sections in the same batch.
+func (bi *BloomIndexer) IndexSection(section uint64, blooms []ethtypes.Bloom) error {
+	sections, err := bi.Sections()
+	if err != nil {
+		return err
+	}
+	if section != sections {
+		return fmt.Errorf("IndexSection %d, expect section %d", section, sections)
+	}
+	if uint64(len(blooms)) != bi.sectionSize {
+		return fmt.Errorf("IndexSection %d, expect %d blooms, got %d", section, bi.sectionSize, len(blooms))
+	}
+
+	gen, err := bloombits.NewGenerator(uint(bi.sectionSize))
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexSection %d", section)
+	}
+	for i, bloom := range blooms {
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return errorsmod.Wrapf(err, "IndexSection %d", section)
+		}
+	}
+
+	batch := bi.db.NewBatch()
+	defer batch.Close()
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexSection %d", section)
+		}
+		// the vector of a bit never set is compressed to empty bytes, which must not be nil
+		compVector := append([]byte{}, bitutil.CompressBytes(bits)...)
+		if err := batch.Set(BloomBitsKey(bit, section), compVector); err != nil {
+			return errorsmod.Wrapf(err, "IndexSection %d", section)
+		}
+	}
+	if err := batch.Set([]byte{KeyPrefixSections}, sdk.Uint64ToBigEndian(section+1)); err != nil {
+		return errorsmod.Wrapf(err, "IndexSection %d", section)
+	}
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexSection %d, write batch", section)
+	}
+	return nil
+}
+
+// BloomBits returns the compressed bit vector of a bloom bit in a section
+func (bi *BloomIndexer) BloomBits(bit uint, section uint64) ([]byte, error) {
+	sections, err := bi.Sections()
+	if err != nil {
+		return nil, err
+	}
+	if section >= sections {
+		return nil, fmt.Errorf("bloom bits not found, bit: %d, section: %d", bit, section)
+	}
+
+	bz, err := bi.db.Get(BloomBitsKey(bit, section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BloomBits %d %d", bit, section)
+	}
+	return bz, nil
+}
+
+// BloomBitsKey returns the key for db entry: `(bit, section) -> compressed bit vector`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	key := make([]byte, BloomBitsKeyLength)
+	key[0] = KeyPrefixBloomBits
+	binary.BigEndian.PutUint16(key[1:], uint16(bit)) // #nosec G115
+	binary.BigEndian.PutUint64(key[3:], section)
+	return key
+}
@@ -0,0 +1,120 @@
+package indexer_test
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/indexer"
+)
+
+func TestBloomIndexer(t *testing.T) {
+	idxer := indexer.NewBloomIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	size := idxer.SectionSize()
+
+	sections, err := idxer.Sections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sections)
+
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	topic := common.HexToHash("0x01")
+	matched := map[uint64]bool{5: true, size + 7: true}
+
+	newSection := func(section uint64) []ethtypes.Bloom {
+		blooms := make([]ethtypes.Bloom, size)
+		for i := range blooms {
+			if matched[section*size+uint64(i)] {
+				blooms[i].Add(addr.Bytes())
+				blooms[i].Add(topic.Bytes())
+			}
+		}
+		return blooms
+	}
+
+	// the sections must be indexed in order and complete
+	require.Error(t, idxer.IndexSection(1, newSection(1)))
+	require.Error(t, idxer.IndexSection(0, newSection(0)[:size-1]))
+	_, err = idxer.BloomBits(0, 0)
+	require.Error(t, err)
+
+	require.NoError(t, idxer.IndexSection(0, newSection(0)))
+	require.NoError(t, idxer.IndexSection(1, newSection(1)))
+	require.Error(t, idxer.IndexSection(1, newSection(1)))
+
+	sections, err = idxer.Sections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sections)
+
+	// every bit vector is stored, including the ones never set
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		compVector, err := idxer.BloomBits(bit, 0)
+		require.NoError(t, err)
+		require.NotNil(t, compVector)
+	}
+	_, err = idxer.BloomBits(0, 2)
+	require.Error(t, err)
+
+	testCases := []struct {
+		name    string
+		filters [][][]byte
+		exp     []uint64
+	}{
+		{"address", [][][]byte{{addr.Bytes()}}, []uint64{5, size + 7}},
+		{"address and topic", [][][]byte{{addr.Bytes()}, {topic.Bytes()}}, []uint64{5, size + 7}},
+		{"other address", [][][]byte{{common.HexToAddress("0x02").Bytes()}}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, matchBlocks(t, idxer, tc.filters, 0, 2*size-1))
+		})
+	}
+}
+
+// matchBlocks runs a bloombits matcher over the bit vectors of the indexer, and returns the matched blocks.
+func matchBlocks(t *testing.T, idxer *indexer.BloomIndexer, filters [][][]byte, begin, end uint64) []uint64 {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	size := idxer.SectionSize()
+	matches := make(chan uint64, 64)
+	session, err := bloombits.NewMatcher(size, filters).Start(ctx, begin, end, matches)
+	require.NoError(t, err)
+	defer session.Close()
+
+	mux := make(chan chan *bloombits.Retrieval)
+	go session.Multiplex(16, 0, mux)
+	go func() {
+		for {
+			select {
+			case request := <-mux:
+				task := <-request
+				task.Bitsets = make([][]byte, len(task.Sections))
+				for i, section := range task.Sections {
+					compVector, err := idxer.BloomBits(task.Bit, section)
+					if err == nil {
+						task.Bitsets[i], err = bitutil.DecompressBytes(compVector, int(size/8))
+					}
+					task.Error = err
+				}
+				request <- task
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var blocks []uint64
+	for number := range matches {
+		blocks = append(blocks, number)
+	}
+	require.NoError(t, session.Error())
+	return blocks
+}
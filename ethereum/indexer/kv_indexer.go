@@ -0,0 +1,266 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+const (
+	KeyPrefixTxHash    = 1
+	KeyPrefixTxIndex   = 2
+	KeyPrefixLastBlock = 3
+
+	// TxIndexKeyLength is the length of tx-index key
+	TxIndexKeyLength = 1 + 8 + 8
+)
+
+var _ artelatypes.EVMTxIndexer = &KVIndexer{}
+
+// KVIndexer implements a eth tx indexer on a KV db.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+	chainID   *big.Int
+}
+
+// NewKVIndexer creates the KVIndexer
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) (*KVIndexer, error) {
+	chainID, err := artelatypes.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &KVIndexer{db, logger, clientCtx, chainID}, nil
+}
+
+// IndexBlock index all the eth txs in a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds and stores a indexer.TxResult based on parsed events for every message
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*txs.MsgEthereumTx)
+			txHash := ethMsg.AsTransaction().Hash()
+
+			txResult := artelatypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  // #nosec G701
+				MsgIndex:   uint32(msgIndex), // #nosec G701
+				EthTxIndex: ethTxIndex,
+			}
+
+			var sender common.Address
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+				sender = parsedTx.From
+			}
+
+			if sender == (common.Address{}) {
+				// the sender is missing from events, recover it from the signature
+				if sender, err = ethMsg.GetSender(kv.chainID); err != nil {
+					kv.logger.Error("failed to recover tx sender", "err", err, "block", height, "hash", txHash.Hex())
+				}
+			}
+			txResult.Sender = sender.Hex()
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+	}
+	// the blocks without eth txs are recorded too, so they are not indexed again on restart
+	lastBlock := sdk.Uint64ToBigEndian(uint64(height)) // #nosec G701
+	if err := batch.Set(LastBlockKey(), lastBlock); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set last block key", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	return LoadLastBlock(kv.db)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	return LoadFirstBlock(kv.db)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*artelatypes.TxResult, error) {
+	bz, err := kv.db.Get(TxHashKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	var txKey artelatypes.TxResult
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txKey, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*artelatypes.TxResult, error) {
+	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return kv.GetByTxHash(common.BytesToHash(bz))
+}
+
+// TxHashKey returns the key for db entry: `tx hash -> tx result struct`
+func TxHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
+}
+
+// TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
+func TxIndexKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) // #nosec G701
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     // #nosec G701
+	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
+}
+
+// LastBlockKey returns the key for db entry: `last indexed block number`
+func LastBlockKey() []byte {
+	return []byte{KeyPrefixLastBlock}
+}
+
+// LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
+func LoadLastBlock(db dbm.DB) (int64, error) {
+	bz, err := db.Get(LastBlockKey())
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LoadLastBlock")
+	}
+	if len(bz) > 0 {
+		return int64(sdk.BigEndianToUint64(bz)), nil // #nosec G701
+	}
+
+	// the db was written before the last block was recorded, take it from the indexed txs
+	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LoadLastBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromKey(it.Key())
+}
+
+// LoadFirstBlock loads the first indexed block, returns -1 if db is empty
+func LoadFirstBlock(db dbm.DB) (int64, error) {
+	it, err := db.Iterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LoadFirstBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromKey(it.Key())
+}
+
+// isEthTx check if the tx is an eth tx
+func isEthTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(*txs.MsgEthereumTx); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// saveTxResult index the txResult into the kv db batch
+func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *artelatypes.TxResult) error {
+	bz := codec.MustMarshal(txResult)
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-hash key")
+	}
+	if err := batch.Set(TxIndexKey(txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set tx-index key")
+	}
+	return nil
+}
+
+func parseBlockNumberFromKey(key []byte) (int64, error) {
+	if len(key) != TxIndexKeyLength {
+		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:9])), nil // #nosec G701
+}
@@ -0,0 +1,184 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/x/evm/txs"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	encodingConfig := app.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithChainID("artela_11822-1").
+		WithCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	to := common.BigToAddress(big.NewInt(1))
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(11822))
+	ethTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{
+		Nonce:    0,
+		To:       &to,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	ethTxBz, err := clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// a cosmos tx, and a tx without any msgs
+	cosmosBuilder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, cosmosBuilder.SetMsgs(banktypes.NewMsgSend(
+		sdk.AccAddress(from.Bytes()), sdk.AccAddress(to.Bytes()), sdk.NewCoins(sdk.NewInt64Coin("aart", 1)))))
+	cosmosTxBz, err := clientCtx.TxConfig.TxEncoder()(cosmosBuilder.GetTx())
+	require.NoError(t, err)
+	emptyTxBz, err := clientCtx.TxConfig.TxEncoder()(clientCtx.TxConfig.NewTxBuilder().GetTx())
+	require.NoError(t, err)
+
+	ethResult := &abci.ResponseDeliverTx{
+		Code:    0,
+		GasUsed: 21000,
+		Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: ethTx.Hash().Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+			}},
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: ethTx.Hash().Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		block     *tmtypes.Block
+		results   []*abci.ResponseDeliverTx
+		expEthTxs int
+	}{
+		{
+			"success, eth tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{ethTxBz}}},
+			[]*abci.ResponseDeliverTx{ethResult},
+			1,
+		},
+		{
+			"fail, failed eth tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{ethTxBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 15, Log: "nonce mismatch"}},
+			0,
+		},
+		{
+			"fail, cosmos tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{cosmosTxBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0}},
+			0,
+		},
+		{
+			"fail, tx without msgs",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{emptyTxBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0}},
+			0,
+		},
+		{
+			"success, eth tx after other txs",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{emptyTxBz, cosmosTxBz, ethTxBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0}, {Code: 0}, ethResult},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer, err := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+			require.NoError(t, err)
+
+			require.NoError(t, idxer.IndexBlock(tc.block, tc.results))
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+
+			// the block is indexed even if it has no eth txs
+			require.Equal(t, int64(1), last)
+
+			if tc.expEthTxs == 0 {
+				require.Equal(t, int64(-1), first)
+
+				_, err := idxer.GetByTxHash(ethTx.Hash())
+				require.Error(t, err)
+				return
+			}
+
+			require.Equal(t, int64(1), first)
+
+			res1, err := idxer.GetByTxHash(ethTx.Hash())
+			require.NoError(t, err)
+			require.NotNil(t, res1)
+			require.Equal(t, int32(0), res1.EthTxIndex)
+			require.Equal(t, uint64(21000), res1.GasUsed)
+			require.Equal(t, from.Hex(), res1.Sender)
+
+			res2, err := idxer.GetByBlockAndIndex(1, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+		})
+	}
+}
+
+func TestKVIndexerLastIndexedBlock(t *testing.T) {
+	encodingConfig := app.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithChainID("artela_11822-1").
+		WithCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	db := dbm.NewMemDB()
+	idxer, err := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// the db written before the last block was recorded falls back to the indexed txs
+	require.NoError(t, db.Set(indexer.TxIndexKey(3, 0), common.Hash{}.Bytes()))
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	// the empty blocks move the last indexed block
+	for _, height := range []int64{4, 5} {
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil))
+	}
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -111,11 +112,33 @@ func (b *BackendImpl) ImportRawKey(privkey, password string) (common.Address, er
 }
 
 func (b *BackendImpl) SignTransaction(args *ethapi2.TransactionArgs) (*ethtypes.Transaction, error) {
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(args.From.Bytes()))
+	if args.From == nil {
+		return nil, errors.New("sender not specified")
+	}
+	kr := b.signingKeyring(*args.From)
+	_, err := kr.KeyByAddress(sdktypes.AccAddress(args.From.Bytes()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
 	}
 
+	return b.signTransaction(args, kr)
+}
+
+// SignTransactionWithPassphrase signs the transaction with the key of args.From, which is
+// decrypted with the given password.
+func (b *BackendImpl) SignTransactionWithPassphrase(args *ethapi2.TransactionArgs, password string) (*ethtypes.Transaction, error) {
+	if args.From == nil {
+		return nil, errors.New("sender not specified")
+	}
+	kr, err := b.passphraseKeyring(*args.From, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.signTransaction(args, kr)
+}
+
+func (b *BackendImpl) signTransaction(args *ethapi2.TransactionArgs, kr keyring.Keyring) (*ethtypes.Transaction, error) {
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
 		return nil, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
 	}
@@ -141,20 +164,39 @@ func (b *BackendImpl) SignTransaction(args *ethapi2.TransactionArgs) (*ethtypes.
 
 	// Sign transaction
 	msg := args.ToEVMTransaction()
-	return msg.SignEthereumTx(signer, b.clientCtx.Keyring)
+	return msg.SignEthereumTx(signer, kr)
 }
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *BackendImpl) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
 	from := sdktypes.AccAddress(address.Bytes())
+	kr := b.signingKeyring(address)
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	_, err := kr.KeyByAddress(from)
 	if err != nil {
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
 	}
 
 	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data)
+	signature, _, err := kr.SignByAddress(from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
+
+// SignTextWithPassphrase signs the EIP-191 hash of the data, i.e.
+// keccak256("\x19Ethereum Signed Message:\n" + len(data) + data), with the key of the address,
+// which is decrypted with the given password.
+func (b *BackendImpl) SignTextWithPassphrase(address common.Address, password string, data hexutil.Bytes) (hexutil.Bytes, error) {
+	kr, err := b.passphraseKeyring(address, password)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _, err := kr.SignByAddress(sdktypes.AccAddress(address.Bytes()), accounts.TextHash(data))
 	if err != nil {
 		return nil, err
 	}
@@ -223,34 +265,7 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 		return nonce, nil
 	}
 
-	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
-	// to manually add them.
-	pendingTxs, err := b.PendingTransactions()
-	if err != nil {
-		return nonce, nil
-	}
-
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
-	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*txs.MsgEthereumTx)
-			if !ok {
-				// not ethereum tx
-				break
-			}
-
-			sender, err := b.GetSender(ethMsg, b.chainID)
-			if err != nil {
-				continue
-			}
-			if sender == accAddr {
-				nonce++
-			}
-		}
-	}
-
-	return nonce, nil
+	return b.pendingNonce(accAddr, nonce), nil
 }
 
 func (b *BackendImpl) GetBalance(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
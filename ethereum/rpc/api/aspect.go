@@ -0,0 +1,149 @@
+package api
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	evmtxs "github.com/artela-network/artela/x/evm/txs"
+)
+
+// AspectBackend is the collection of methods required to satisfy the aspect
+// RPC API.
+type AspectBackend interface {
+	AspectCode(aspectId common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectCodeResponse, error)
+	AspectJoinPoint(aspectId common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectJoinPointResponse, error)
+	AspectProperties(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]evmtxs.AspectProperty, error)
+	AspectBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectBindingsResponse, error)
+	AspectBoundAccounts(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]common.Address, error)
+	AspectOperation(from, aspectId common.Address, data []byte, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, error)
+}
+
+// AspectCodeResult is the result of aspect_getCode.
+type AspectCodeResult struct {
+	Code    hexutil.Bytes  `json:"code"`
+	Version hexutil.Uint64 `json:"version"`
+}
+
+// AspectJoinPointResult is the result of aspect_getJoinPoints.
+type AspectJoinPointResult struct {
+	JoinPoint  hexutil.Uint64 `json:"joinPoint"`
+	JoinPoints []string       `json:"joinPoints"`
+	Version    hexutil.Uint64 `json:"version"`
+}
+
+// AspectBinding is an aspect bound to an account.
+type AspectBinding struct {
+	AspectId common.Address `json:"aspectId"`
+	Version  hexutil.Uint64 `json:"version"`
+	Priority int64          `json:"priority"`
+}
+
+// AspectBindingsResult is the result of aspect_getBindings.
+type AspectBindingsResult struct {
+	Contract []AspectBinding `json:"contract"`
+	Verifier []AspectBinding `json:"verifier"`
+}
+
+// AspectAPI offers aspect introspection and simulation RPC methods.
+type AspectAPI struct {
+	backend AspectBackend
+}
+
+// NewAspectAPI creates a new AspectAPI instance.
+func NewAspectAPI(backend AspectBackend) *AspectAPI {
+	return &AspectAPI{backend: backend}
+}
+
+// GetCode returns the code of the aspect at the given version,
+// the latest version is used if the version is not provided.
+func (a *AspectAPI) GetCode(aspectId common.Address, version *hexutil.Uint64, blockNrOrHash *rpc.BlockNumberOrHash) (*AspectCodeResult, error) {
+	res, err := a.backend.AspectCode(aspectId, uint64OrZero(version), blockNumberOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+	return &AspectCodeResult{
+		Code:    res.Code,
+		Version: hexutil.Uint64(res.Version),
+	}, nil
+}
+
+// GetJoinPoints returns the join points of the aspect at the given version,
+// the latest version is used if the version is not provided.
+func (a *AspectAPI) GetJoinPoints(aspectId common.Address, version *hexutil.Uint64, blockNrOrHash *rpc.BlockNumberOrHash) (*AspectJoinPointResult, error) {
+	res, err := a.backend.AspectJoinPoint(aspectId, uint64OrZero(version), blockNumberOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+	joinPoints := res.JoinPointNames
+	if joinPoints == nil {
+		joinPoints = []string{}
+	}
+	return &AspectJoinPointResult{
+		JoinPoint:  hexutil.Uint64(res.JoinPoint),
+		JoinPoints: joinPoints,
+		Version:    hexutil.Uint64(res.Version),
+	}, nil
+}
+
+// GetProperties returns all the properties of the aspect.
+func (a *AspectAPI) GetProperties(aspectId common.Address, blockNrOrHash *rpc.BlockNumberOrHash) (map[string]hexutil.Bytes, error) {
+	props, err := a.backend.AspectProperties(aspectId, blockNumberOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]hexutil.Bytes, len(props))
+	for _, prop := range props {
+		result[prop.Key] = prop.Value
+	}
+	return result, nil
+}
+
+// GetBindings returns the aspects bound to the account.
+func (a *AspectAPI) GetBindings(account common.Address, blockNrOrHash *rpc.BlockNumberOrHash) (*AspectBindingsResult, error) {
+	res, err := a.backend.AspectBindings(account, blockNumberOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+	return &AspectBindingsResult{
+		Contract: toAspectBindings(res.ContractBindings),
+		Verifier: toAspectBindings(res.VerifierBindings),
+	}, nil
+}
+
+// GetBoundAddresses returns the accounts bound to the aspect.
+func (a *AspectAPI) GetBoundAddresses(aspectId common.Address, blockNrOrHash *rpc.BlockNumberOrHash) ([]common.Address, error) {
+	return a.backend.AspectBoundAccounts(aspectId, blockNumberOrLatest(blockNrOrHash))
+}
+
+// CallOperation simulates the operation join point of the aspect with the given call data
+// and returns its output, the state changes are not committed.
+func (a *AspectAPI) CallOperation(from, aspectId common.Address, data hexutil.Bytes, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return a.backend.AspectOperation(from, aspectId, data, blockNumberOrLatest(blockNrOrHash))
+}
+
+func toAspectBindings(bindings []evmtxs.AspectBinding) []AspectBinding {
+	result := make([]AspectBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		result = append(result, AspectBinding{
+			AspectId: common.HexToAddress(binding.AspectId),
+			Version:  hexutil.Uint64(binding.Version),
+			Priority: binding.Priority,
+		})
+	}
+	return result
+}
+
+func blockNumberOrLatest(blockNrOrHash *rpc.BlockNumberOrHash) rpc.BlockNumberOrHash {
+	if blockNrOrHash == nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	}
+	return *blockNrOrHash
+}
+
+func uint64OrZero(value *hexutil.Uint64) uint64 {
+	if value == nil {
+		return 0
+	}
+	return uint64(*value)
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	evmtxs "github.com/artela-network/artela/x/evm/txs"
+)
+
+// testAspectBackend serves a single aspect, and records the requested versions and blocks.
+type testAspectBackend struct {
+	versions []uint64
+	blocks   []rpc.BlockNumberOrHash
+}
+
+func (b *testAspectBackend) record(version uint64, blockNrOrHash rpc.BlockNumberOrHash) {
+	b.versions = append(b.versions, version)
+	b.blocks = append(b.blocks, blockNrOrHash)
+}
+
+func (b *testAspectBackend) AspectCode(_ common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectCodeResponse, error) {
+	b.record(version, blockNrOrHash)
+	return &evmtxs.QueryAspectCodeResponse{Code: []byte{0x1}, Version: 2}, nil
+}
+
+func (b *testAspectBackend) AspectJoinPoint(_ common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectJoinPointResponse, error) {
+	b.record(version, blockNrOrHash)
+	return &evmtxs.QueryAspectJoinPointResponse{Version: 2}, nil
+}
+
+func (b *testAspectBackend) AspectProperties(_ common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]evmtxs.AspectProperty, error) {
+	b.record(0, blockNrOrHash)
+	return []evmtxs.AspectProperty{{Key: "owner", Value: []byte{0x1}}}, nil
+}
+
+func (b *testAspectBackend) AspectBindings(_ common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*evmtxs.QueryAspectBindingsResponse, error) {
+	b.record(0, blockNrOrHash)
+	return &evmtxs.QueryAspectBindingsResponse{
+		VerifierBindings: []evmtxs.AspectBinding{{AspectId: "0x0000000000000000000000000000000000000001", Version: 2, Priority: 1}},
+	}, nil
+}
+
+func (b *testAspectBackend) AspectBoundAccounts(_ common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]common.Address, error) {
+	b.record(0, blockNrOrHash)
+	return []common.Address{common.HexToAddress("0x2")}, nil
+}
+
+func (b *testAspectBackend) AspectOperation(_, _ common.Address, data []byte, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, error) {
+	b.record(0, blockNrOrHash)
+	return append([]byte{0xff}, data...), nil
+}
+
+func TestAspectAPI(t *testing.T) {
+	backend := &testAspectBackend{}
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("aspect", NewAspectAPI(backend)))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	aspectID := common.HexToAddress("0x1")
+	latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+
+	var code AspectCodeResult
+	require.NoError(t, client.Call(&code, "aspect_getCode", aspectID))
+	require.Equal(t, AspectCodeResult{Code: []byte{0x1}, Version: 2}, code)
+	require.NoError(t, client.Call(&code, "aspect_getCode", aspectID, "0x1", "0x5"))
+
+	// the join points are never null
+	var joinPoints map[string]interface{}
+	require.NoError(t, client.Call(&joinPoints, "aspect_getJoinPoints", aspectID))
+	require.Equal(t, []interface{}{}, joinPoints["joinPoints"])
+
+	var properties map[string]hexutil.Bytes
+	require.NoError(t, client.Call(&properties, "aspect_getProperties", aspectID))
+	require.Equal(t, map[string]hexutil.Bytes{"owner": {0x1}}, properties)
+
+	var bindings AspectBindingsResult
+	require.NoError(t, client.Call(&bindings, "aspect_getBindings", common.HexToAddress("0x2")))
+	require.Empty(t, bindings.Contract)
+	require.Equal(t, []AspectBinding{{AspectId: aspectID, Version: 2, Priority: 1}}, bindings.Verifier)
+
+	var accounts []common.Address
+	require.NoError(t, client.Call(&accounts, "aspect_getBoundAddresses", aspectID))
+	require.Equal(t, []common.Address{common.HexToAddress("0x2")}, accounts)
+
+	var ret hexutil.Bytes
+	require.NoError(t, client.Call(&ret, "aspect_callOperation", common.HexToAddress("0x2"), aspectID, hexutil.Bytes{0x3}))
+	require.Equal(t, hexutil.Bytes{0xff, 0x3}, ret)
+
+	// the latest version and block are used unless given
+	block5 := rpc.BlockNumberOrHashWithNumber(5)
+	require.Equal(t, []uint64{0, 1, 0, 0, 0, 0, 0}, backend.versions)
+	require.Equal(t, []rpc.BlockNumberOrHash{latest, block5, latest, latest, latest, latest, latest}, backend.blocks)
+}
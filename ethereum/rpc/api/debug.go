@@ -3,7 +3,9 @@ package api
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/user"
@@ -28,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
 	evmtxs "github.com/artela-network/artela/x/evm/txs"
 	evmsupport "github.com/artela-network/artela/x/evm/txs/support"
 )
@@ -42,6 +45,53 @@ type DebugBackend interface {
 	CosmosBlockByNumber(blockNum rpc.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*ethtypes.Header, error)
 	BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*ethtypes.Block, error)
+	TraceEthBlock(block *ethtypes.Block, config *evmsupport.TraceConfig) ([]*evmtxs.TxTraceResult, error)
+	TraceCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *evmsupport.TraceConfig,
+		overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides) (interface{}, error)
+	RPCBlockRangeCap() int32
+}
+
+// TraceConfig holds extra parameters to trace functions. The tracer config can be
+// passed either as a JSON object, like geth does, or as a JSON encoded string.
+type TraceConfig struct {
+	evmsupport.TraceConfig
+	TracerConfig json.RawMessage `json:"tracerConfig,omitempty"`
+}
+
+// toSupport converts the config to the one accepted by the evm module.
+func (c *TraceConfig) toSupport() *evmsupport.TraceConfig {
+	if c == nil {
+		return &evmsupport.TraceConfig{}
+	}
+
+	config := c.TraceConfig
+	config.TracerJsonConfig = ""
+	if len(c.TracerConfig) > 0 && !bytes.Equal(c.TracerConfig, []byte("null")) {
+		var jsonConfig string
+		if err := json.Unmarshal(c.TracerConfig, &jsonConfig); err == nil {
+			config.TracerJsonConfig = jsonConfig
+		} else {
+			config.TracerJsonConfig = string(c.TracerConfig)
+		}
+	}
+	return &config
+}
+
+// TraceCallConfig is the config for traceCall DebugAPI. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *ethapi.StateOverride
+	BlockOverrides *ethapi.BlockOverrides
+}
+
+// blockTraceResult represents the results of tracing a single block when an entire
+// chain is being traced.
+type blockTraceResult struct {
+	Block  hexutil.Uint64          `json:"block"`
+	Hash   common.Hash             `json:"hash"`
+	Traces []*evmtxs.TxTraceResult `json:"traces"`
+	Error  string                  `json:"error,omitempty"`
 }
 
 // HandlerT keeps track of the cpu profiler and trace execution
@@ -59,6 +109,9 @@ type DebugAPI struct {
 	logger  log.Logger
 	backend DebugBackend
 	handler *HandlerT
+
+	// chainTracers bounds the blocks traced in parallel by all the chain traces
+	chainTracers chan struct{}
 }
 
 // NewDebugAPI creates a new DebugAPI definition for the tracing methods of the Ethereum service.
@@ -66,20 +119,22 @@ func NewDebugAPI(
 	backend DebugBackend,
 ) *DebugAPI {
 	return &DebugAPI{
-		backend: backend,
-		handler: new(HandlerT),
+		logger:       log.NewNopLogger(),
+		backend:      backend,
+		handler:      new(HandlerT),
+		chainTracers: make(chan struct{}, runtime.NumCPU()),
 	}
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *DebugAPI) TraceTransaction(hash common.Hash, config evmsupport.TraceConfig) (interface{}, error) {
-	return a.backend.TraceTransaction(hash, &config)
+func (a *DebugAPI) TraceTransaction(hash common.Hash, config *TraceConfig) (interface{}, error) {
+	return a.backend.TraceTransaction(hash, config.toSupport())
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *DebugAPI) TraceBlockByNumber(height rpc.BlockNumber, config evmsupport.TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+func (a *DebugAPI) TraceBlockByNumber(height rpc.BlockNumber, config *TraceConfig) ([]*evmtxs.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -91,12 +146,12 @@ func (a *DebugAPI) TraceBlockByNumber(height rpc.BlockNumber, config evmsupport.
 		return nil, err
 	}
 
-	return a.backend.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), &config, resBlock)
+	return a.backend.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), config.toSupport(), resBlock)
 }
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *DebugAPI) TraceBlockByHash(hash common.Hash, config evmsupport.TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+func (a *DebugAPI) TraceBlockByHash(hash common.Hash, config *TraceConfig) ([]*evmtxs.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
 	// Get Tendermint Block
 	resBlock, err := a.backend.CosmosBlockByHash(hash)
@@ -110,7 +165,120 @@ func (a *DebugAPI) TraceBlockByHash(hash common.Hash, config evmsupport.TraceCon
 		return nil, errors.New("block not found")
 	}
 
-	return a.backend.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), &config, resBlock)
+	return a.backend.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), config.toSupport(), resBlock)
+}
+
+// TraceBlock returns the structured logs created during the execution of EVM
+// on top of the state at the beginning of the block with the same height as the RLP
+// encoded block, and returns them as a JSON object.
+func (a *DebugAPI) TraceBlock(blob hexutil.Bytes, config *TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+	block := new(ethtypes.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
+	}
+
+	return a.backend.TraceEthBlock(block, config.toSupport())
+}
+
+// TraceChain returns the structured logs created during the execution of EVM
+// between two blocks (excluding start) and returns them as a subscription.
+// The range is capped by the block range cap of the node, and at most NumCPU blocks
+// are traced in parallel by all the chain traces. The results are delivered in block order.
+func (a *DebugAPI) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*rpc.Subscription, error) {
+	from, err := a.backend.CosmosBlockByNumber(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := a.backend.CosmosBlockByNumber(end)
+	if err != nil {
+		return nil, err
+	}
+	if from.Block.Height >= to.Block.Height {
+		return nil, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", end, start)
+	}
+	if limit := int64(a.backend.RPCBlockRangeCap()); limit > 0 && to.Block.Height-from.Block.Height > limit {
+		return nil, fmt.Errorf("block range %d exceeds the limit %d", to.Block.Height-from.Block.Height, limit)
+	}
+
+	// Tracing a chain is a **long** operation, only do with subscriptions
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	go a.traceChain(notifier, sub, from.Block.Height, to.Block.Height, config.toSupport())
+	return sub, nil
+}
+
+// traceChain traces the blocks in (start, end] and sends the results to the subscriber,
+// it stops once the subscription is closed or a block failed to be traced.
+func (a *DebugAPI) traceChain(notifier *rpc.Notifier, sub *rpc.Subscription, start, end int64, config *evmsupport.TraceConfig) {
+	threads := int64(cap(a.chainTracers))
+	for begin := start + 1; begin <= end; begin += threads {
+		last := begin + threads - 1
+		if last > end {
+			last = end
+		}
+
+		results := make([]*blockTraceResult, last-begin+1)
+		var pend sync.WaitGroup
+		for height := begin; height <= last; height++ {
+			pend.Add(1)
+			go func(height int64) {
+				defer pend.Done()
+				a.chainTracers <- struct{}{}
+				defer func() { <-a.chainTracers }()
+				results[height-begin] = a.traceChainBlock(height, config)
+			}(height)
+		}
+		pend.Wait()
+
+		for _, result := range results {
+			select {
+			case <-sub.Err():
+				return
+			default:
+			}
+
+			if err := notifier.Notify(sub.ID, result); err != nil {
+				a.logger.Debug("failed to notify chain trace", "block", uint64(result.Block), "error", err.Error())
+				return
+			}
+			if result.Error != "" {
+				return
+			}
+		}
+	}
+}
+
+func (a *DebugAPI) traceChainBlock(height int64, config *evmsupport.TraceConfig) *blockTraceResult {
+	result := &blockTraceResult{Block: hexutil.Uint64(height)}
+
+	resBlock, err := a.backend.CosmosBlockByNumber(rpc.BlockNumber(height))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Hash = common.BytesToHash(resBlock.BlockID.Hash)
+
+	traces, err := a.backend.TraceBlock(rpc.BlockNumber(height), config, resBlock)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Traces = traces
+	return result
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *DebugAPI) TraceCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
+	if config == nil {
+		return a.backend.TraceCall(args, blockNrOrHash, nil, nil, nil)
+	}
+	return a.backend.TraceCall(args, blockNrOrHash, config.TraceConfig.toSupport(), config.StateOverrides, config.BlockOverrides)
 }
 
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
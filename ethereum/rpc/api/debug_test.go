@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	evmtxs "github.com/artela-network/artela/x/evm/txs"
+	evmsupport "github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// testDebugBackend records the traced calls.
+type testDebugBackend struct {
+	DebugBackend
+
+	configs        []*evmsupport.TraceConfig
+	overrides      []*ethapi.StateOverride
+	blockOverrides []*ethapi.BlockOverrides
+
+	// the chain traced by TraceBlock, and the most blocks traced at once
+	head     int64
+	rangeCap int32
+	mu       sync.Mutex
+	tracing  int
+	maxTrace int
+}
+
+func (b *testDebugBackend) CosmosBlockByNumber(blockNum rpc.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	height := blockNum.Int64()
+	if blockNum == rpc.LatestBlockNumber {
+		height = b.head
+	}
+	return &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: common.BigToHash(big.NewInt(height)).Bytes()},
+		Block:   &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+	}, nil
+}
+
+func (b *testDebugBackend) TraceBlock(height rpc.BlockNumber, _ *evmsupport.TraceConfig, _ *tmrpctypes.ResultBlock) ([]*evmtxs.TxTraceResult, error) {
+	b.mu.Lock()
+	b.tracing++
+	if b.tracing > b.maxTrace {
+		b.maxTrace = b.tracing
+	}
+	b.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	b.mu.Lock()
+	b.tracing--
+	b.mu.Unlock()
+	return []*evmtxs.TxTraceResult{{Result: height.Int64()}}, nil
+}
+
+func (b *testDebugBackend) RPCBlockRangeCap() int32 {
+	return b.rangeCap
+}
+
+func (b *testDebugBackend) TraceCall(_ ethapi.TransactionArgs, _ rpc.BlockNumberOrHash, config *evmsupport.TraceConfig,
+	overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+) (interface{}, error) {
+	b.configs = append(b.configs, config)
+	b.overrides = append(b.overrides, overrides)
+	b.blockOverrides = append(b.blockOverrides, blockOverrides)
+	return map[string]interface{}{"gas": 21000}, nil
+}
+
+func TestTraceCall(t *testing.T) {
+	backend := &testDebugBackend{}
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("debug", NewDebugAPI(backend)))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	args := map[string]interface{}{"from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002"}
+	var res map[string]interface{}
+	require.NoError(t, client.Call(&res, "debug_traceCall", args, "latest"))
+	require.Equal(t, map[string]interface{}{"gas": float64(21000)}, res)
+
+	// the overrides are passed along with the trace config
+	require.NoError(t, client.Call(&res, "debug_traceCall", args, "0x5", map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"onlyTopCall": true},
+		"stateOverrides": map[string]interface{}{
+			"0x0000000000000000000000000000000000000001": map[string]interface{}{"balance": "0x64", "nonce": "0x5"},
+		},
+		"blockOverrides": map[string]interface{}{"number": "0x10", "time": "0x20"},
+	}))
+
+	require.Len(t, backend.configs, 2)
+	require.Nil(t, backend.configs[0])
+	require.Nil(t, backend.overrides[0])
+	require.Nil(t, backend.blockOverrides[0])
+
+	require.Equal(t, "callTracer", backend.configs[1].Tracer)
+	require.JSONEq(t, `{"onlyTopCall": true}`, backend.configs[1].TracerJsonConfig)
+
+	account := (*backend.overrides[1])[common.HexToAddress("0x1")]
+	require.Equal(t, big.NewInt(100), (*account.Balance).ToInt())
+	require.Equal(t, uint64(5), uint64(*account.Nonce))
+	require.Equal(t, big.NewInt(0x10), backend.blockOverrides[1].Number.ToInt())
+	require.Equal(t, uint64(0x20), uint64(*backend.blockOverrides[1].Time))
+}
+
+func TestTraceChain(t *testing.T) {
+	backend := &testDebugBackend{head: 100, rangeCap: 20}
+	api := NewDebugAPI(backend)
+	api.chainTracers = make(chan struct{}, 2)
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("debug", api))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	// the range is capped
+	results := make(chan *blockTraceResult, 20)
+	_, err := client.Subscribe(context.Background(), "debug", results, "traceChain", "0x1", "0x16")
+	require.EqualError(t, err, "block range 21 exceeds the limit 20")
+	_, err = client.Subscribe(context.Background(), "debug", results, "traceChain", "0x5", "0x5")
+	require.Error(t, err)
+
+	// two chains traced at once, the results are delivered in block order
+	other := make(chan *blockTraceResult, 20)
+	sub, err := client.Subscribe(context.Background(), "debug", results, "traceChain", "0x1", "0x15")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	otherSub, err := client.Subscribe(context.Background(), "debug", other, "traceChain", "0x1", "0x15")
+	require.NoError(t, err)
+	defer otherSub.Unsubscribe()
+
+	for _, ch := range []chan *blockTraceResult{results, other} {
+		for height := int64(2); height <= 0x15; height++ {
+			select {
+			case result := <-ch:
+				require.Equal(t, uint64(height), uint64(result.Block))
+				require.Equal(t, common.BigToHash(big.NewInt(height)), result.Hash)
+				require.Empty(t, result.Error)
+				require.Len(t, result.Traces, 1)
+				require.Equal(t, float64(height), result.Traces[0].Result)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block %d not traced", height)
+			}
+		}
+	}
+
+	// the blocks traced in parallel are bounded across the chains
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	require.LessOrEqual(t, backend.maxTrace, 2)
+}
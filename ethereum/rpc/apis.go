@@ -1,7 +1,6 @@
 package rpc
 
 import (
-	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -11,7 +10,7 @@ import (
 	"github.com/artela-network/artela/ethereum/rpc/filters"
 )
 
-func GetAPIs(clientCtx client.Context, wsClient *rpcclient.WSClient, logger log.Logger, apiBackend *BackendImpl) []rpc.API {
+func GetAPIs(clientCtx client.Context, events *filters.EventSystem, logger log.Logger, apiBackend *BackendImpl) []rpc.API {
 	nonceLock := new(ethapi.AddrLocker)
 	return []rpc.API{
 		{
@@ -43,10 +42,13 @@ func GetAPIs(clientCtx client.Context, wsClient *rpcclient.WSClient, logger log.
 			Service:   api.NewNetAPI(apiBackend),
 		}, {
 			Namespace: "eth",
-			Service:   filters.NewPublicFilterAPI(logger, clientCtx, wsClient, apiBackend),
+			Service:   filters.NewPublicFilterAPI(logger, clientCtx, events, apiBackend),
 		}, {
 			Namespace: "web3",
 			Service:   api.NewWeb3API(apiBackend),
+		}, {
+			Namespace: "aspect",
+			Service:   api.NewAspectAPI(apiBackend),
 		},
 	}
 }
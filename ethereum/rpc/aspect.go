@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/common/aspect"
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// AspectCode returns the code of the aspect at the given version, 0 stands for the latest version.
+func (b *BackendImpl) AspectCode(aspectId common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*txs.QueryAspectCodeResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectCodeRequest{
+		AspectId: aspectId.Hex(),
+		Version:  version,
+	}
+	return b.queryClient.AspectCode(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// AspectJoinPoint returns the join points of the aspect at the given version, 0 stands for the latest version.
+func (b *BackendImpl) AspectJoinPoint(aspectId common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*txs.QueryAspectJoinPointResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectJoinPointRequest{
+		AspectId: aspectId.Hex(),
+		Version:  version,
+	}
+	return b.queryClient.AspectJoinPoint(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// AspectProperties returns all the properties of the aspect.
+func (b *BackendImpl) AspectProperties(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]txs.AspectProperty, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectPropertiesRequest{
+		AspectId: aspectId.Hex(),
+	}
+	res, err := b.queryClient.AspectProperties(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Properties, nil
+}
+
+// AspectBindings returns the aspects bound to the account.
+func (b *BackendImpl) AspectBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*txs.QueryAspectBindingsResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectBindingsRequest{
+		Address: account.Hex(),
+	}
+	return b.queryClient.AspectBindings(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// AspectBoundAccounts returns the accounts bound to the aspect.
+func (b *BackendImpl) AspectBoundAccounts(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]common.Address, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectBoundAccountsRequest{
+		AspectId: aspectId.Hex(),
+	}
+	res, err := b.queryClient.AspectBoundAccounts(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]common.Address, 0, len(res.Accounts))
+	for _, account := range res.Accounts {
+		accounts = append(accounts, common.HexToAddress(account))
+	}
+	return accounts, nil
+}
+
+// AspectOperation simulates a call to the operation join point of the aspect
+// through the entrypoint of the aspect system contract, the changes are not committed.
+func (b *BackendImpl) AspectOperation(from, aspectId common.Address, data []byte, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, error) {
+	input, err := aspect.PackMethod("entrypoint", aspectId, data)
+	if err != nil {
+		return nil, err
+	}
+
+	to := aspect.SystemContractAddress
+	args := ethapi.TransactionArgs{
+		From:  &from,
+		To:    &to,
+		Input: (*hexutil.Bytes)(&input),
+	}
+	res, err := b.DoCall(args, blockNrOrHash, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := aspect.UnpackMethodOutput("entrypoint", res.Ret)
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) == 0 {
+		return nil, errors.New("empty operation output")
+	}
+	ret, ok := outputs[0].([]byte)
+	if !ok {
+		return nil, errors.New("invalid operation output")
+	}
+	return ret, nil
+}
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/common/aspect"
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+func TestAspectOperation(t *testing.T) {
+	// the output of the entrypoint of the system contract
+	bytesType, err := abi.NewType("bytes", "", nil)
+	require.NoError(t, err)
+	ret, err := abi.Arguments{{Type: bytesType}}.Pack([]byte{0x1, 0x2})
+	require.NoError(t, err)
+
+	queryClient := &testEVMQueryClient{ret: ret}
+	b := &BackendImpl{
+		ctx:         context.Background(),
+		clientCtx:   client.Context{}.WithClient(testCometClient{}),
+		queryClient: &rpctypes.QueryClient{QueryClient: queryClient},
+		cfg:         &Config{RPCGasCap: 25000000},
+		chainID:     big.NewInt(11822),
+	}
+
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	aspectID := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	blockNum := rpc.BlockNumber(5)
+	res, err := b.AspectOperation(from, aspectID, []byte{0x3}, rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1, 0x2}, res)
+
+	// the operation is simulated by a call to the entrypoint of the system contract
+	require.Len(t, queryClient.requests, 1)
+	require.Equal(t, []string{"5"}, queryClient.heights)
+	var args ethapi.TransactionArgs
+	require.NoError(t, json.Unmarshal(queryClient.requests[0].Args, &args))
+	require.Equal(t, from, *args.From)
+	require.Equal(t, aspect.SystemContractAddress, *args.To)
+	input, err := aspect.PackMethod("entrypoint", aspectID, []byte{0x3})
+	require.NoError(t, err)
+	require.Equal(t, input, []byte(*args.Input))
+
+	// the output must be the one of the entrypoint
+	queryClient.ret = []byte{0x1}
+	_, err = b.AspectOperation(from, aspectID, []byte{0x3}, rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.Error(t, err)
+}
@@ -8,11 +8,13 @@ import (
 	"math/big"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	bftclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -22,7 +24,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
-	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -43,6 +44,15 @@ import (
 	feetypes "github.com/artela-network/artela/x/fee/types"
 )
 
+// maxUnconfirmedTxs is the max page size of the tendermint unconfirmed_txs query.
+const maxUnconfirmedTxs = 100
+
+// TxReaper reads the txs in the mempool of the tendermint node.
+type TxReaper interface {
+	// ReapMaxTxs returns at most max txs of the mempool, or all of them if max is negative.
+	ReapMaxTxs(max int) tmtypes.Txs
+}
+
 var (
 	_ gasprice.OracleBackend = (*BackendImpl)(nil)
 	_ ethapi2.Backend        = (*BackendImpl)(nil)
@@ -73,6 +83,17 @@ type BackendImpl struct {
 	ctx         context.Context
 	clientCtx   client.Context
 	queryClient *rpctypes.QueryClient
+	indexer     ethereumtypes.EVMTxIndexer
+	// bloomIndexer is nil if the bloom indexer is disabled
+	bloomIndexer ethereumtypes.EVMBloomIndexer
+	// evmMempool is nil if the app-side mempool is disabled
+	evmMempool ethereumtypes.EVMMempool
+	// tmMempool is the mempool of the node running in process, nil otherwise
+	tmMempool TxReaper
+
+	// unlocked keeps the keyrings opened for the accounts unlocked by personal_unlockAccount
+	unlockedMu sync.Mutex
+	unlocked   map[common.Address]*unlockedKeyring
 }
 
 func (b *BackendImpl) EthBlockByNumber(blockNum rpc.BlockNumber) (*ethtypes.Block, error) {
@@ -88,6 +109,9 @@ func NewBackend(
 	extRPCEnabled bool,
 	cfg *Config,
 	logger log.Logger,
+	indexer ethereumtypes.EVMTxIndexer,
+	bloomIndexer ethereumtypes.EVMBloomIndexer,
+	evmMempool ethereumtypes.EVMMempool,
 ) *BackendImpl {
 	b := &BackendImpl{
 		ctx:           context.Background(),
@@ -97,6 +121,10 @@ func NewBackend(
 		logger:        logger,
 		clientCtx:     clientCtx,
 		queryClient:   rpctypes.NewQueryClient(clientCtx),
+		indexer:       indexer,
+		bloomIndexer:  bloomIndexer,
+		evmMempool:    evmMempool,
+		unlocked:      make(map[common.Address]*unlockedKeyring),
 
 		scope: event.SubscriptionScope{},
 	}
@@ -325,13 +353,6 @@ func (b *BackendImpl) SubscribePendingLogsEvent(ch chan<- []*ethtypes.Log) event
 	return b.scope.Track(b.pendingLogsFeed.Subscribe(ch))
 }
 
-func (b *BackendImpl) BloomStatus() (uint64, uint64) {
-	return 0, 0
-}
-
-func (b *BackendImpl) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
-}
-
 // artela rpc DebugAPI
 
 // artela rpc DebugAPI
@@ -399,8 +420,49 @@ func (b *BackendImpl) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int
 	return res.BaseFee.BigInt(), nil
 }
 
+// unconfirmedTxs returns the raw txs in the mempool. The whole mempool is read if the node
+// runs in process, otherwise only the first maxUnconfirmedTxs txs are returned, since the
+// tendermint unconfirmed_txs query has no paging.
+func (b *BackendImpl) unconfirmedTxs() (tmtypes.Txs, error) {
+	if b.tmMempool != nil {
+		return b.tmMempool.ReapMaxTxs(-1), nil
+	}
+
+	mc, ok := b.clientCtx.Client.(bftclient.MempoolClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	limit := maxUnconfirmedTxs
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
+	if err != nil {
+		return nil, err
+	}
+	if res.Total > res.Count {
+		b.logger.Debug("only the first txs of the remote mempool are read", "count", res.Count, "total", res.Total)
+	}
+	return res.Txs, nil
+}
+
+// PendingTransactions returns the transactions that are in the mempool,
+// the txs failed to be decoded are skipped.
 func (b *BackendImpl) PendingTransactions() ([]*sdktypes.Tx, error) {
-	return nil, errors.New("PendingTransactions is not implemented")
+	unconfirmedTxs, err := b.unconfirmedTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*sdktypes.Tx, 0, len(unconfirmedTxs))
+	for _, txBz := range unconfirmedTxs {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode pending tx", "hash", hexutil.Encode(txBz.Hash()), "error", err)
+			continue
+		}
+		result = append(result, &tx)
+	}
+
+	return result, nil
 }
 
 func (b *BackendImpl) GasPrice(ctx context.Context) (*hexutil.Big, error) {
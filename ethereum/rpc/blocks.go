@@ -442,49 +442,36 @@ func (b *BackendImpl) EthMsgsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, b
 	return result
 }
 
-func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error) {
+func (b *BackendImpl) DoCall(
+	args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash,
+	overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides,
+) (*txs.MsgEthereumTxResponse, error) {
 	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
 
-	bz, err := json.Marshal(&args)
+	req, err := b.newCallRequest(args, blockNum)
 	if err != nil {
 		return nil, err
 	}
-	header, err := b.CosmosBlockByNumber(blockNum)
-	if err != nil {
-		// the error message imitates geth behavior
-		return nil, errors.New("header not found")
-	}
-
-	req := txs.EthCallRequest{
-		Args:            bz,
-		GasCap:          b.RPCGasCap(),
-		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
-		ChainId:         b.chainID.Int64(),
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
 	}
-
-	// From ContextWithHeight: if the provided height is 0,
-	// it will return an empty context and the gRPC query will use
-	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
-	timeout := b.RPCEVMTimeout()
-
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
-	var cancel context.CancelFunc
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(ctx)
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
 	}
 
+	ctx, cancel := b.callContext(blockNum)
 	// Make sure the context is canceled when the call has completed
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.queryClient.EthCall(ctx, &req)
+	res, err := b.queryClient.EthCall(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +486,39 @@ func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.Bloc
 	return res, nil
 }
 
+// newCallRequest returns the request executing the call on top of the state of the block.
+func (b *BackendImpl) newCallRequest(args ethapi.TransactionArgs, blockNum rpc.BlockNumber) (*txs.EthCallRequest, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	return &txs.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}, nil
+}
+
+// callContext returns the context of the call queried at the block, the context is canceled
+// once the evm timeout is elapsed if any.
+func (b *BackendImpl) callContext(blockNum rpc.BlockNumber) (context.Context, context.CancelFunc) {
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
 func (b *BackendImpl) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
 	return b.blockBloom(blockRes)
 }
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+)
+
+const (
+	// bloomServiceThreads is the number of goroutines used by a filter session
+	// to service the bloombits lookups from the bloom index.
+	bloomServiceThreads = 16
+
+	// bloomFilterThreads is the number of goroutines used by a filter session
+	// to multiplex the bloombits requests.
+	bloomFilterThreads = 3
+
+	// bloomRetrievalBatch is the maximum number of bloom bit retrievals to service
+	// in a single batch.
+	bloomRetrievalBatch = 16
+
+	// bloomRetrievalWait is the maximum time to wait for enough bloom bit requests
+	// to accumulate request an entire batch (avoiding hysteresis).
+	bloomRetrievalWait = time.Duration(0)
+)
+
+// BloomStatus returns the section size and the number of indexed sections of the bloom index,
+// no section is indexed if the bloom indexer is disabled.
+func (b *BackendImpl) BloomStatus() (uint64, uint64) {
+	if b.bloomIndexer == nil {
+		return 0, 0
+	}
+
+	sections, err := b.bloomIndexer.Sections()
+	if err != nil {
+		b.logger.Debug("failed to load bloom sections", "error", err.Error())
+		return b.bloomIndexer.SectionSize(), 0
+	}
+	return b.bloomIndexer.SectionSize(), sections
+}
+
+// ServiceFilter services the bloombits retrievals of a matcher session from the bloom index,
+// until the context is canceled.
+func (b *BackendImpl) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
+	if b.bloomIndexer == nil {
+		return
+	}
+
+	requests := make(chan chan *bloombits.Retrieval)
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, requests)
+	}
+	for i := 0; i < bloomServiceThreads; i++ {
+		go b.serveBloomRequests(ctx, requests)
+	}
+}
+
+// serveBloomRequests loads the bit vectors of the retrieval tasks from the bloom index.
+func (b *BackendImpl) serveBloomRequests(ctx context.Context, requests chan chan *bloombits.Retrieval) {
+	sectionSize := b.bloomIndexer.SectionSize()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case request := <-requests:
+			// the task must be answered once the request is accepted
+			task := <-request
+			task.Bitsets = make([][]byte, len(task.Sections))
+			for i, section := range task.Sections {
+				compVector, err := b.bloomIndexer.BloomBits(task.Bit, section)
+				if err != nil {
+					task.Error = err
+					continue
+				}
+				blob, err := bitutil.DecompressBytes(compVector, int(sectionSize/8))
+				if err != nil {
+					task.Error = err
+					continue
+				}
+				task.Bitsets[i] = blob
+			}
+			request <- task
+		}
+	}
+}
@@ -3,6 +3,7 @@ package ethapi
 import (
 	"context"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +31,8 @@ type Backend interface {
 	Accounts() []common.Address
 	NewAccount(password string) (common.AddressEIP55, error)
 	ImportRawKey(privkey, password string) (common.Address, error)
+	UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error)
+	LockAccount(address common.Address) bool
 	GetTransactionCount(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error)
 	GetBalance(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error)
 
@@ -54,11 +57,16 @@ type Backend interface {
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
 	GetTransaction(ctx context.Context, txHash common.Hash) (*RPCTransaction, error)
 	SignTransaction(args *TransactionArgs) (*types.Transaction, error)
+	SignTransactionWithPassphrase(args *TransactionArgs, password string) (*types.Transaction, error)
 	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
 	RPCTxFeeCap() float64
 	UnprotectedAllowed() bool
 	EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
-	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error)
+	CreateAccessList(args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*txs.CreateAccessListResponse, error)
+	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (*txs.MsgEthereumTxResponse, error)
+	Stats() (pending int, queued int)
+	TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
 
 	ChainConfig() *params.ChainConfig
 	Engine() consensus.Engine
@@ -66,6 +74,7 @@ type Backend interface {
 	Syncing() (interface{}, error)
 	// This is copied from filters.Backend
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
+	SignTextWithPassphrase(address common.Address, password string, data hexutil.Bytes) (hexutil.Bytes, error)
 
 	GetCoinbase() (sdk.AccAddress, error)
 
@@ -7,13 +7,14 @@ import (
 	"time"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
-	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/artela-network/artela/ethereum/rpc/types"
@@ -45,6 +46,7 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -54,6 +56,9 @@ type Backend interface {
 // consider a filter inactive if it has not been polled for within deadline
 var deadline = 5 * time.Minute
 
+// activeFiltersGauge reports the number of the filters installed
+var activeFiltersGauge = metrics.NewRegisteredGauge("rpc/filters/active", nil)
+
 // filter is a helper struct that holds meta information over the filter type
 // and associated subscription in the event system.
 type filter struct {
@@ -76,14 +81,15 @@ type PublicFilterAPI struct {
 	filters   map[rpc.ID]*filter
 }
 
-// NewPublicFilterAPI returns a new PublicFilterAPI instance.
-func NewPublicFilterAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend) *PublicFilterAPI {
+// NewPublicFilterAPI returns a new PublicFilterAPI instance, the filters are served
+// until the event system is stopped.
+func NewPublicFilterAPI(logger log.Logger, clientCtx client.Context, events *EventSystem, backend Backend) *PublicFilterAPI {
 	api := &PublicFilterAPI{
 		logger:    logger,
 		clientCtx: clientCtx,
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
-		events:    NewEventSystem(logger, tmWSClient),
+		events:    events,
 	}
 
 	go api.timeoutLoop()
@@ -92,19 +98,23 @@ func NewPublicFilterAPI(logger log.Logger, clientCtx client.Context, tmWSClient
 }
 
 // timeoutLoop runs every 5 minutes and deletes filters that have not been recently used.
-// Tt is started when the api is created.
+// Tt is started when the api is created, and stops along with the event system.
 func (api *PublicFilterAPI) timeoutLoop() {
 	ticker := time.NewTicker(deadline)
 	defer ticker.Stop()
 
 	for {
-		<-ticker.C
+		select {
+		case <-ticker.C:
+		case <-api.events.Done():
+			return
+		}
 		api.filtersMu.Lock()
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
 				f.s.Unsubscribe(api.events)
-				delete(api.filters, id)
+				api.deleteFilter(id)
 			default:
 				continue
 			}
@@ -113,6 +123,18 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	}
 }
 
+// addFilter installs the filter of the id, filtersMu must be held.
+func (api *PublicFilterAPI) addFilter(id rpc.ID, f *filter) {
+	api.filters[id] = f
+	activeFiltersGauge.Update(int64(len(api.filters)))
+}
+
+// deleteFilter uninstalls the filter of the id, filtersMu must be held.
+func (api *PublicFilterAPI) deleteFilter(id rpc.ID) {
+	delete(api.filters, id)
+	activeFiltersGauge.Update(int64(len(api.filters)))
+}
+
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
 // as transactions enter the pending state.
 //
@@ -134,12 +156,12 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{
+	api.addFilter(pendingTxSub.ID(), &filter{
 		typ:      filters.PendingTransactionsSubscription,
 		deadline: time.NewTimer(deadline),
 		hashes:   make([]common.Hash, 0),
 		s:        pendingTxSub,
-	}
+	})
 
 	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -149,7 +171,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -178,7 +200,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
+				api.deleteFilter(pendingTxSub.ID())
 				api.filtersMu.Unlock()
 			}
 		}
@@ -189,7 +211,8 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// If fullTx is true the full tx is sent to the client, otherwise the hash is sent.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
@@ -215,7 +238,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -234,9 +257,22 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*txs.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
+					if !ok {
+						continue
+					}
+
+					tx := ethTx.AsTransaction()
+					if fullTx == nil || !*fullTx {
+						_ = notifier.Notify(rpcSub.ID, tx.Hash())
+						continue
+					}
+
+					rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, tx.ChainId())
+					if err != nil {
+						api.logger.Debug("failed to build rpc transaction", "hash", tx.Hash(), "error", err.Error())
+						continue
 					}
+					_ = notifier.Notify(rpcSub.ID, rpcTx)
 				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
@@ -269,7 +305,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
 	}
 
-	api.filters[headerSub.ID()] = &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub}
+	api.addFilter(headerSub.ID(), &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub})
 
 	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -279,7 +315,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 			case ev, ok := <-headersCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, headerSub.ID())
+					api.deleteFilter(headerSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -297,7 +333,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, headerSub.ID())
+				api.deleteFilter(headerSub.ID())
 				api.filtersMu.Unlock()
 				return
 			}
@@ -339,10 +375,7 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 					continue
 				}
 
-				baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
-
-				// TODO: fetch bloom from events
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				header := types.EthHeaderFromNewBlockHeader(data)
 				_ = notifier.Notify(rpcSub.ID, header)
 			case <-rpcSub.Err():
 				headersSub.Unsubscribe(api.events)
@@ -372,9 +405,54 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 		return &rpc.Subscription{}, err
 	}
 
+	// the historical logs up to the head are replayed if fromBlock is specified
+	var replayHead int64
+	if ShouldReplayLogs(crit) {
+		if replayHead, err = ReplayHead(ctx, api.backend); err != nil {
+			logsSub.Unsubscribe(api.events)
+			cancelSubs()
+			return &rpc.Subscription{}, err
+		}
+	}
+
 	go func(logsCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
 
+		// replay the historical logs first if fromBlock is specified, meanwhile the live events
+		// are buffered, and the ones of the replayed blocks are skipped afterwards.
+		var bufferErrCh <-chan error
+		if ShouldReplayLogs(crit) {
+			done := make(chan struct{})
+			defer close(done)
+			logsCh, bufferErrCh = BufferEvents(logsCh, done)
+
+			// stop replaying once the client unsubscribes or disconnects
+			replayCtx, cancel := context.WithCancel(context.Background())
+			go func() {
+				defer cancel()
+				select {
+				case <-rpcSub.Err():
+				case <-notifier.Closed():
+				case <-replayCtx.Done():
+				}
+			}()
+
+			replayErr := ReplayLogs(replayCtx, api.logger, api.backend, crit, replayHead, func(logs []*ethtypes.Log) error {
+				for _, log := range logs {
+					if err := notifier.Notify(rpcSub.ID, log); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			cancel()
+			if replayErr != nil {
+				api.logger.Debug("failed to replay logs", "subscription-id", rpcSub.ID, "error", replayErr.Error())
+				logsSub.Unsubscribe(api.events)
+				return
+			}
+		}
+
 		for {
 			select {
 			case ev, ok := <-logsCh:
@@ -398,6 +476,11 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 					continue
 				}
 
+				// the logs of this block have been replayed already
+				if dataTx.TxResult.Height <= replayHead {
+					continue
+				}
+
 				txResponse, err := txs.DecodeTxResponse(dataTx.TxResult.Result.Data)
 				if err != nil {
 					api.logger.Error("fail to decode tx response", "error", err)
@@ -409,6 +492,10 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 				for _, log := range logs {
 					_ = notifier.Notify(rpcSub.ID, log)
 				}
+			case err := <-bufferErrCh: // too many live events buffered while replaying
+				api.logger.Debug("dropping logs subscription", "subscription-id", rpcSub.ID, "error", err.Error())
+				logsSub.Unsubscribe(api.events)
+				return
 			case <-rpcSub.Err(): // client send an unsubscribe request
 				logsSub.Unsubscribe(api.events)
 				return
@@ -455,13 +542,13 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 
 	filterID = logsSub.ID()
 
-	api.filters[filterID] = &filter{
+	api.addFilter(filterID, &filter{
 		typ:      filters.LogsSubscription,
 		crit:     criteria,
 		deadline: time.NewTimer(deadline),
 		hashes:   []common.Hash{},
 		s:        logsSub,
-	}
+	})
 
 	go func(eventCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
@@ -471,7 +558,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 			case ev, ok := <-eventCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, filterID)
+					api.deleteFilter(filterID)
 					api.filtersMu.Unlock()
 					return
 				}
@@ -496,7 +583,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 				api.filtersMu.Unlock()
 			case <-logsSub.Err():
 				api.filtersMu.Lock()
-				delete(api.filters, filterID)
+				api.deleteFilter(filterID)
 				api.filtersMu.Unlock()
 				return
 			}
@@ -544,7 +631,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
 	f, found := api.filters[id]
 	if found {
-		delete(api.filters, id)
+		api.deleteFilter(id)
 	}
 	api.filtersMu.Unlock()
 
@@ -32,6 +32,8 @@ var (
 		sdk.EventTypeMessage,
 		sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
 	headerEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
+
+	errEventSystemStopped = errors.New("event system stopped")
 )
 
 // EventSystem creates subscriptions, processes events and broadcasts them to the
@@ -52,6 +54,9 @@ type EventSystem struct {
 	install   chan *Subscription // install filter for event notification
 	uninstall chan *Subscription // remove filter for event notification
 	eventBus  pubsub.EventBus
+
+	quit     chan struct{} // closed when the event system is stopped
+	stopOnce *sync.Once
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -77,6 +82,8 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 		install:    make(chan *Subscription),
 		uninstall:  make(chan *Subscription),
 		eventBus:   pubsub.NewEventBus(),
+		quit:       make(chan struct{}),
+		stopOnce:   new(sync.Once),
 	}
 
 	go es.eventLoop()
@@ -90,6 +97,19 @@ func (es *EventSystem) WithContext(ctx context.Context) {
 	es.ctx = ctx
 }
 
+// Stop stops the event loops, the subscriptions can no longer be installed or receive
+// events afterwards. The cometbft websocket client is not stopped, it is owned by the caller.
+func (es *EventSystem) Stop() {
+	es.stopOnce.Do(func() {
+		close(es.quit)
+	})
+}
+
+// Done returns a channel closed when the event system is stopped.
+func (es *EventSystem) Done() <-chan struct{} {
+	return es.quit
+}
+
 // subscribe performs a new event subscription to a given cometbft event.
 // The subscription creates a unidirectional receive event channel to receive the ResultEvent.
 func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.UnsubscribeFunc, error) {
@@ -132,7 +152,11 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 	}
 
 	// wrap events in a go routine to prevent blocking
-	es.install <- sub
+	select {
+	case es.install <- sub:
+	case <-es.quit:
+		return nil, nil, errEventSystemStopped
+	}
 	<-sub.installed
 
 	eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
@@ -261,12 +285,20 @@ func (es *EventSystem) eventLoop() {
 
 			es.indexMux.Unlock()
 			close(f.err)
+		case <-es.quit:
+			return
 		}
 	}
 }
 
 func (es *EventSystem) consumeEvents() {
 	for {
+		select {
+		case <-es.quit:
+			return
+		default:
+		}
+
 		for rpcResp := range es.tmWSClient.ResponsesCh {
 			var ev coretypes.ResultEvent
 
@@ -298,9 +330,17 @@ func (es *EventSystem) consumeEvents() {
 			case <-t.C:
 				es.logger.Debug("dropped event during lagging subscription", "topic", ev.Query)
 			case ch <- ev:
+				t.Stop()
+			case <-es.quit:
+				t.Stop()
+				return
 			}
 		}
 
-		time.Sleep(time.Second)
+		select {
+		case <-es.quit:
+			return
+		case <-time.After(time.Second):
+		}
 	}
 }
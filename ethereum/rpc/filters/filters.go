@@ -10,6 +10,7 @@ import (
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -26,6 +27,15 @@ type BloomIV struct {
 	V [3]byte
 }
 
+// logsLimitError is returned if the logs matching the filter exceed the logs limit.
+type logsLimitError struct {
+	limit int
+}
+
+func (e *logsLimitError) Error() string {
+	return fmt.Sprintf("query returned more than %d results", e.limit)
+}
+
 // Filter can be used to retrieve and filter logs.
 type Filter struct {
 	logger   log.Logger
@@ -33,6 +43,7 @@ type Filter struct {
 	criteria filters.FilterCriteria
 
 	bloomFilters [][]BloomIV // Filter the system is matching for
+	matcher      *bloombits.Matcher
 }
 
 // NewBlockFilter creates a new filter which directly inspects the contents of
@@ -73,7 +84,23 @@ func NewRangeFilter(logger log.Logger, backend Backend, begin, end int64, addres
 		Topics:    topics,
 	}
 
-	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	f := newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	// the sections covered by the bloom index are searched with the bloombits matcher, the matcher
+	// matches every block without address and topic filters, so the range is not indexed then.
+	if size, _ := backend.BloomStatus(); size > 0 && hasFilterClause(filtersBz) {
+		f.matcher = bloombits.NewMatcher(size, filtersBz)
+	}
+	return f
+}
+
+// hasFilterClause returns true if any of the address and topic filter clauses is not a wildcard.
+func hasFilterClause(filtersBz [][][]byte) bool {
+	for _, filter := range filtersBz {
+		if len(filter) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // newFilter returns a new Filter
@@ -92,7 +119,7 @@ const (
 
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
-func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
+func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
 
@@ -140,7 +167,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// the blocks covered by the bloom index are not limited by the block range cap
+	unindexedFrom := f.criteria.FromBlock.Int64()
+	if indexed := f.indexedBlocks(); indexed > unindexedFrom {
+		unindexedFrom = indexed
+	}
+	if f.criteria.ToBlock.Int64()-unindexedFrom > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
@@ -154,6 +186,18 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	if indexed := f.indexedBlocks(); indexed > from {
+		end := indexed - 1
+		if end > to {
+			end = to
+		}
+		logs, err = f.indexedLogs(ctx, from, end, logLimit)
+		if err != nil {
+			return nil, err
+		}
+		from = end + 1
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
 		if err != nil {
@@ -173,13 +217,75 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 
 		// check logs limit
 		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			return nil, &logsLimitError{limit: logLimit}
 		}
 		logs = append(logs, filtered...)
 	}
 	return logs, nil
 }
 
+// indexedBlocks returns the number of blocks covered by the bloom index, 0 if there is no bloom index.
+func (f *Filter) indexedBlocks() int64 {
+	if f.matcher == nil {
+		return 0
+	}
+	size, sections := f.backend.BloomStatus()
+	return int64(size * sections) // #nosec G701
+}
+
+// indexedLogs returns the logs matching the filter criteria within the blocks covered by the bloom index,
+// only the blocks matched by the bloombits matcher are fetched.
+func (f *Filter) indexedLogs(ctx context.Context, from, to int64, logLimit int) ([]*ethtypes.Log, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	matches := make(chan uint64, 64)
+	session, err := f.matcher.Start(ctx, uint64(from), uint64(to), matches) // #nosec G701
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	f.backend.ServiceFilter(ctx, session)
+
+	logs := []*ethtypes.Log{}
+	for {
+		select {
+		case number, ok := <-matches:
+			if !ok {
+				if err := session.Error(); err != nil {
+					return nil, errors.Wrap(err, "failed to match the bloom index")
+				}
+				return logs, nil
+			}
+
+			height := int64(number) // #nosec G701
+			blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch block result %d", height)
+			}
+
+			bloom, err := f.backend.BlockBloom(blockRes)
+			if err != nil {
+				return nil, err
+			}
+
+			filtered, err := f.blockLogs(blockRes, bloom)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+			}
+
+			// check logs limit
+			if len(logs)+len(filtered) > logLimit {
+				return nil, &logsLimitError{limit: logLimit}
+			}
+			logs = append(logs, filtered...)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
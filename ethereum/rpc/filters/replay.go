@@ -0,0 +1,158 @@
+package filters
+
+import (
+	"context"
+	"errors"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultReplayRange is the max number of blocks replayed at once for a logs subscription,
+// if the block range cap is not configured.
+const defaultReplayRange = 1000
+
+// maxBufferedEvents is the max number of live events buffered while replaying the historical logs,
+// the subscription is dropped if the receiver falls further behind.
+const maxBufferedEvents = 4096
+
+// ErrEventBufferOverflow is returned if too many live events are buffered for the receiver.
+var ErrEventBufferOverflow = errors.New("too many events buffered, subscription dropped")
+
+// ShouldReplayLogs returns true if the logs subscription asks for the logs since a specific block.
+func ShouldReplayLogs(crit filters.FilterCriteria) bool {
+	return crit.FromBlock != nil && crit.FromBlock.Sign() >= 0
+}
+
+// ReplayHead returns the current head block, up to which the historical logs are replayed by ReplayLogs.
+// It must be called once the live events are subscribed, so that the logs of the blocks after the head
+// are delivered by the live events.
+func ReplayHead(ctx context.Context, backend Backend) (int64, error) {
+	header, err := backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil || header.Number == nil {
+		return 0, errors.New("header not found")
+	}
+	return header.Number.Int64(), nil
+}
+
+// ReplayLogs sends the historical logs matching the criteria, from the fromBlock of the criteria up to
+// the head block returned by ReplayHead. The range is searched with range filters, so that the blocks
+// are pre-filtered with their blooms. Blocks are final once committed, so the replayed logs are never
+// reorged out.
+//
+// The range is replayed in pages of at most the block range cap, a page is split further if its logs
+// exceed the logs cap, so that any range can be replayed within the caps. Only the logs of a single
+// block exceeding the logs cap fail the replay.
+//
+// The live logs of the blocks up to the head must be skipped by the caller to avoid duplicates. The live
+// events should be buffered while replaying, see BufferEvents, to avoid gaps.
+func ReplayLogs(ctx context.Context, logger log.Logger, backend Backend, crit filters.FilterCriteria, head int64, send func([]*ethtypes.Log) error) error {
+	begin, end := replayBounds(crit, head)
+	pageSize := replayRange(backend)
+	for from := begin; from <= end; from += pageSize {
+		to := from + pageSize - 1
+		if to > end {
+			to = end
+		}
+		if err := replayPage(ctx, logger, backend, crit, from, to, send); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayPage sends the logs of the blocks in [from, to], the range is halved until its logs are within
+// the logs cap.
+func replayPage(ctx context.Context, logger log.Logger, backend Backend, crit filters.FilterCriteria, from, to int64, send func([]*ethtypes.Log) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logs, err := NewRangeFilter(logger, backend, from, to, crit.Addresses, crit.Topics).
+		Logs(ctx, int(backend.RPCLogsCap()), to-from)
+	var limitErr *logsLimitError
+	if errors.As(err, &limitErr) && from < to {
+		mid := from + (to-from)/2
+		if err := replayPage(ctx, logger, backend, crit, from, mid, send); err != nil {
+			return err
+		}
+		return replayPage(ctx, logger, backend, crit, mid+1, to, send)
+	}
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+	return send(logs)
+}
+
+// replayRange returns the max number of blocks replayed at once for a logs subscription.
+func replayRange(backend Backend) int64 {
+	if limit := int64(backend.RPCBlockRangeCap()); limit > 0 {
+		return limit
+	}
+	return defaultReplayRange
+}
+
+// replayBounds returns the range of the blocks replayed for the criteria, the chain starts at block 1.
+func replayBounds(crit filters.FilterCriteria, head int64) (int64, int64) {
+	begin, end := crit.FromBlock.Int64(), head
+	if begin < 1 {
+		begin = 1
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Sign() >= 0 && crit.ToBlock.Int64() < head {
+		end = crit.ToBlock.Int64()
+	}
+	return begin, end
+}
+
+// BufferEvents forwards the events from src to the returned channel through a queue of at most
+// maxBufferedEvents events, so that the event bus doesn't drop any event while the receiver is busy,
+// e.g. replaying logs. The returned channel is closed once src is closed and drained, or done is
+// closed. If the queue overflows, ErrEventBufferOverflow is sent to the returned error channel and
+// the event channel is closed, the subscription should be dropped then.
+func BufferEvents(src <-chan coretypes.ResultEvent, done <-chan struct{}) (<-chan coretypes.ResultEvent, <-chan error) {
+	dst := make(chan coretypes.ResultEvent)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(dst)
+
+		var queue []coretypes.ResultEvent
+		for src != nil || len(queue) > 0 {
+			var (
+				out  chan coretypes.ResultEvent
+				next coretypes.ResultEvent
+			)
+			if len(queue) > 0 {
+				out, next = dst, queue[0]
+			}
+
+			select {
+			case ev, ok := <-src:
+				if !ok {
+					src = nil
+					continue
+				}
+				if len(queue) >= maxBufferedEvents {
+					errCh <- ErrEventBufferOverflow
+					return
+				}
+				queue = append(queue, ev)
+			case out <- next:
+				queue = queue[1:]
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return dst, errCh
+}
@@ -0,0 +1,151 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// testReplayBackend serves a chain in which every block has the given number of logs.
+type testReplayBackend struct {
+	Backend
+
+	head      int64
+	logs      map[int64]int
+	logsCap   int32
+	rangeCap  int32
+	requested []int64
+}
+
+func (b *testReplayBackend) HeaderByNumber(context.Context, rpc.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *testReplayBackend) CosmosBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	b.requested = append(b.requested, *height)
+
+	event := abci.Event{Type: evmtypes.EventTypeTxLog}
+	for i := 0; i < b.logs[*height]; i++ {
+		bz, err := json.Marshal(&support.Log{Address: common.HexToAddress("0x1").Hex(), BlockNumber: uint64(*height), Index: uint64(i)})
+		if err != nil {
+			return nil, err
+		}
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+	}
+	return &coretypes.ResultBlockResults{Height: *height, TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{event}}}}, nil
+}
+
+func (b *testReplayBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	bloom.Add(common.HexToAddress("0x1").Bytes())
+	return bloom, nil
+}
+
+func (b *testReplayBackend) BloomStatus() (uint64, uint64) { return 0, 0 }
+func (b *testReplayBackend) RPCLogsCap() int32             { return b.logsCap }
+func (b *testReplayBackend) RPCBlockRangeCap() int32       { return b.rangeCap }
+
+func TestReplayLogs(t *testing.T) {
+	backend := &testReplayBackend{
+		head:     25,
+		logs:     map[int64]int{2: 1, 3: 2, 7: 3, 8: 3, 20: 1, 25: 1},
+		logsCap:  4,
+		rangeCap: 10,
+	}
+
+	// the range far behind the head is replayed
+	head, err := ReplayHead(context.Background(), backend)
+	require.NoError(t, err)
+	require.Equal(t, int64(25), head)
+
+	var (
+		pages  [][]uint64
+		blocks []uint64
+	)
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(22), Addresses: []common.Address{common.HexToAddress("0x1")}}
+	require.NoError(t, ReplayLogs(context.Background(), log.Root(), backend, crit, head, func(logs []*ethtypes.Log) error {
+		require.LessOrEqual(t, len(logs), 4)
+		var page []uint64
+		for _, l := range logs {
+			page = append(page, l.BlockNumber)
+		}
+		pages = append(pages, page)
+		blocks = append(blocks, page...)
+		return nil
+	}))
+
+	// the logs are sent in block order, the pages of more logs than the cap are split
+	require.Equal(t, []uint64{2, 3, 3, 7, 7, 7, 8, 8, 8, 20}, blocks)
+	require.Equal(t, [][]uint64{{2, 3, 3}, {7, 7, 7}, {8, 8, 8}, {20}}, pages)
+	require.Equal(t, int64(1), backend.requested[0])
+	require.NotContains(t, backend.requested, int64(23))
+
+	// the logs of a single block exceeding the cap can't be replayed
+	backend.logs[21] = 5
+	crit = filters.FilterCriteria{FromBlock: big.NewInt(15)}
+	require.EqualError(t, ReplayLogs(context.Background(), log.Root(), backend, crit, head, func([]*ethtypes.Log) error { return nil }),
+		"query returned more than 4 results")
+
+	// the replay stops once the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, ReplayLogs(ctx, log.Root(), backend, crit, head, func([]*ethtypes.Log) error { return nil }), context.Canceled)
+}
+
+func TestBufferEvents(t *testing.T) {
+	src := make(chan coretypes.ResultEvent)
+	done := make(chan struct{})
+	dst, errCh := BufferEvents(src, done)
+
+	// the events are buffered while the receiver is busy, and delivered in order
+	for i := 0; i < 10; i++ {
+		src <- coretypes.ResultEvent{Query: string(rune('a' + i))}
+	}
+	for i := 0; i < 10; i++ {
+		require.Equal(t, string(rune('a'+i)), (<-dst).Query)
+	}
+
+	// the buffered events are drained once the source is closed
+	src <- coretypes.ResultEvent{Query: "last"}
+	close(src)
+	require.Equal(t, "last", (<-dst).Query)
+	_, ok := <-dst
+	require.False(t, ok)
+	require.Empty(t, errCh)
+}
+
+func TestBufferEventsOverflow(t *testing.T) {
+	src := make(chan coretypes.ResultEvent)
+	done := make(chan struct{})
+	defer close(done)
+	dst, errCh := BufferEvents(src, done)
+
+	for i := 0; i <= maxBufferedEvents; i++ {
+		src <- coretypes.ResultEvent{}
+	}
+
+	select {
+	case err := <-errCh:
+		require.ErrorIs(t, err, ErrEventBufferOverflow)
+	case <-time.After(5 * time.Second):
+		t.Fatal("buffer overflow not reported")
+	}
+
+	// the subscription is dropped, the buffered events are discarded
+	_, ok := <-dst
+	require.False(t, ok)
+}
@@ -43,6 +43,8 @@ func (s *Subscription) Unsubscribe(es *EventSystem) {
 			select {
 			case es.uninstall <- s:
 				break uninstallLoop
+			case <-es.quit:
+				break uninstallLoop
 			case <-s.logs:
 			case <-s.hashes:
 			case <-s.headers:
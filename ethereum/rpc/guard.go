@@ -0,0 +1,509 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+const (
+	errCodeInvalidRequest   = -32600
+	errCodeMethodNotFound   = -32601
+	errCodeResponseTooLarge = -32003
+	errCodeLimitExceeded    = -32005
+
+	// maxRequestSize mirrors the request size limit of the rpc server, larger requests are
+	// left to the rpc server to reject
+	maxRequestSize = 5 * 1024 * 1024
+	// clientIdleTimeout is the time after which the rate limiters of an idle client are released
+	clientIdleTimeout = 10 * time.Minute
+)
+
+var (
+	deniedRequestsCounter      = metrics.NewRegisteredCounter("rpc/limits/denied", nil)
+	rateLimitedRequestsCounter = metrics.NewRegisteredCounter("rpc/limits/ratelimited", nil)
+	largeBatchesCounter        = metrics.NewRegisteredCounter("rpc/limits/batchtoolarge", nil)
+	largeResponsesCounter      = metrics.NewRegisteredCounter("rpc/limits/responsetoolarge", nil)
+)
+
+// RequestGuard enforces the access rules and the limits of the json-rpc requests served
+// over http and websockets. The rate limits are tracked per client IP, shared by both, see clientIP.
+type RequestGuard struct {
+	allow           map[string]struct{}
+	deny            map[string]struct{}
+	rate            float64
+	burst           int
+	methodRates     map[string]float64
+	maxBatchSize    int
+	maxResponseSize int
+	trustedProxies  []*net.IPNet
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+// NewRequestGuard creates the request guard from the json-rpc limits config.
+func NewRequestGuard(cfg config.JSONRPCLimitsConfig) (*RequestGuard, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	methodRates, err := cfg.MethodRates()
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies, err := cfg.TrustedProxyNets()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &RequestGuard{
+		allow:           make(map[string]struct{}, len(cfg.AllowList)),
+		deny:            make(map[string]struct{}, len(cfg.DenyList)),
+		rate:            cfg.RateLimit,
+		burst:           cfg.RateBurst,
+		methodRates:     methodRates,
+		maxBatchSize:    cfg.MaxBatchSize,
+		maxResponseSize: cfg.MaxResponseSize,
+		trustedProxies:  trustedProxies,
+		clients:         make(map[string]*clientLimiter),
+		lastSweep:       time.Now(),
+	}
+	for _, rule := range cfg.AllowList {
+		g.allow[rule] = struct{}{}
+	}
+	for _, rule := range cfg.DenyList {
+		g.deny[rule] = struct{}{}
+	}
+	if g.burst == 0 {
+		g.burst = defaultBurst(g.rate)
+	}
+	return g, nil
+}
+
+// guardError is the json-rpc error of a request rejected by the guard.
+type guardError struct {
+	code    int
+	message string
+}
+
+func (e *guardError) Error() string {
+	return e.message
+}
+
+type guardResponse struct {
+	Jsonrpc string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Error   *ErrorMessageJSON `json:"error"`
+}
+
+func newGuardResponse(id json.RawMessage, err *guardError) json.RawMessage {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	res, _ := json.Marshal(&guardResponse{ // marshalling the response never fails
+		Jsonrpc: "2.0",
+		ID:      id,
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(int64(err.code)),
+			Message: err.message,
+		},
+	})
+	return res
+}
+
+// guardedRequest is a json-rpc message or batch checked by the guard.
+type guardedRequest struct {
+	// serve holds the messages to serve, nil if all are rejected
+	serve []byte
+	// errors holds the responses of the rejected messages
+	errors []json.RawMessage
+	// batch is true if the responses are sent as a batch
+	batch bool
+}
+
+// rejections returns the encoded responses of the rejected messages, nil if there are none.
+func (r *guardedRequest) rejections() []byte {
+	if len(r.errors) == 0 {
+		return nil
+	}
+	if !r.batch {
+		return r.errors[0]
+	}
+	res, _ := json.Marshal(r.errors) // the responses are valid json
+	return res
+}
+
+// check applies the access rules and the limits to a json-rpc message or batch of the client.
+// The invalid messages are served as is, so that they are answered by the rpc server.
+func (g *RequestGuard) check(client string, mb []byte) *guardedRequest {
+	if !isBatch(mb) {
+		var msg rpcMessage
+		if err := json.Unmarshal(mb, &msg); err != nil || msg.Method == "" {
+			return &guardedRequest{serve: mb}
+		}
+		if err := g.checkMessage(client, msg.Method); err != nil {
+			req := &guardedRequest{}
+			if msg.expectsResponse() {
+				req.errors = append(req.errors, newGuardResponse(msg.ID, err))
+			}
+			return req
+		}
+		return &guardedRequest{serve: mb}
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(mb, &batch); err != nil {
+		return &guardedRequest{serve: mb}
+	}
+	if g.maxBatchSize > 0 && len(batch) > g.maxBatchSize {
+		largeBatchesCounter.Inc(1)
+		err := &guardError{errCodeInvalidRequest, fmt.Sprintf("batch too large, the maximum batch size is %d", g.maxBatchSize)}
+		return &guardedRequest{errors: []json.RawMessage{newGuardResponse(nil, err)}}
+	}
+
+	req := &guardedRequest{batch: true}
+	served := make([]json.RawMessage, 0, len(batch))
+	for _, raw := range batch {
+		var msg rpcMessage
+		if err := json.Unmarshal(raw, &msg); err != nil || msg.Method == "" {
+			served = append(served, raw)
+			continue
+		}
+		if err := g.checkMessage(client, msg.Method); err != nil {
+			if msg.expectsResponse() {
+				req.errors = append(req.errors, newGuardResponse(msg.ID, err))
+			}
+			continue
+		}
+		served = append(served, raw)
+	}
+
+	switch {
+	case len(served) == len(batch):
+		req.serve = mb
+	case len(served) > 0:
+		req.serve, _ = json.Marshal(served) // the messages are valid json
+	}
+	return req
+}
+
+// checkMessage returns an error if the method is not served, or the client exceeds its rate limits.
+func (g *RequestGuard) checkMessage(client, method string) *guardError {
+	if !g.allowed(method) {
+		deniedRequestsCounter.Inc(1)
+		return &guardError{errCodeMethodNotFound, fmt.Sprintf("the method %s does not exist/is not available", method)}
+	}
+	if !g.allowRate(client, method) {
+		rateLimitedRequestsCounter.Inc(1)
+		return &guardError{errCodeLimitExceeded, fmt.Sprintf("rate limit exceeded for %s", method)}
+	}
+	return nil
+}
+
+// allowed returns true if the method is served by the access rules.
+func (g *RequestGuard) allowed(method string) bool {
+	if _, ok := matchRule(g.deny, method); ok {
+		return false
+	}
+	if len(g.allow) == 0 {
+		return true
+	}
+	_, ok := matchRule(g.allow, method)
+	return ok
+}
+
+// allowRate takes a token from the rate limiters of the client matching the method.
+func (g *RequestGuard) allowRate(client, method string) bool {
+	methodRule, hasMethodRate := matchRule(g.methodRates, method)
+	if g.rate == 0 && !hasMethodRate {
+		return true
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	g.sweep(now)
+
+	limiter, ok := g.clients[client]
+	if !ok {
+		limiter = &clientLimiter{methods: make(map[string]*tokenBucket)}
+		if g.rate > 0 {
+			limiter.all = newTokenBucket(g.rate, g.burst, now)
+		}
+		g.clients[client] = limiter
+	}
+	limiter.lastSeen = now
+
+	if limiter.all != nil && !limiter.all.take(now) {
+		return false
+	}
+	if !hasMethodRate {
+		return true
+	}
+
+	bucket, ok := limiter.methods[methodRule]
+	if !ok {
+		rate := g.methodRates[methodRule]
+		bucket = newTokenBucket(rate, defaultBurst(rate), now)
+		limiter.methods[methodRule] = bucket
+	}
+	return bucket.take(now)
+}
+
+// sweep releases the rate limiters of the idle clients, the caller must hold g.mu.
+func (g *RequestGuard) sweep(now time.Time) {
+	if now.Sub(g.lastSweep) < clientIdleTimeout {
+		return
+	}
+	g.lastSweep = now
+	for client, limiter := range g.clients {
+		if now.Sub(limiter.lastSeen) >= clientIdleTimeout {
+			delete(g.clients, client)
+		}
+	}
+}
+
+// limitResponse replaces the responses exceeding the maximum response size with errors. The
+// responses of a batch are kept in order until the size is exceeded.
+func (g *RequestGuard) limitResponse(resp []byte) []byte {
+	if g.maxResponseSize == 0 || len(resp) <= g.maxResponseSize {
+		return resp
+	}
+	largeResponsesCounter.Inc(1)
+
+	err := &guardError{errCodeResponseTooLarge, fmt.Sprintf("response too large, the maximum response size is %d bytes", g.maxResponseSize)}
+	if !isBatch(resp) {
+		var msg rpcMessage
+		_ = json.Unmarshal(resp, &msg) // the id is null if the response is invalid
+		return newGuardResponse(msg.ID, err)
+	}
+
+	var batch []json.RawMessage
+	if json.Unmarshal(resp, &batch) != nil {
+		return newGuardResponse(nil, err)
+	}
+	size := 0
+	for i, raw := range batch {
+		size += len(raw)
+		if size <= g.maxResponseSize {
+			continue
+		}
+		var msg rpcMessage
+		_ = json.Unmarshal(raw, &msg) // the id is null if the response is invalid
+		batch[i] = newGuardResponse(msg.ID, err)
+	}
+	limited, _ := json.Marshal(batch) // the responses are valid json
+	return limited
+}
+
+// HTTPHandler wraps the http handler of the rpc server with the guard, the rejected messages
+// of a batch are answered along with the responses of the rpc server.
+func (g *RequestGuard) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) > maxRequestSize {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		req := g.check(g.clientIP(r), body)
+		if req.serve == nil {
+			writeHTTPResponse(w, http.StatusOK, req.rejections())
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(req.serve))
+		r.ContentLength = int64(len(req.serve))
+		if len(req.errors) == 0 && g.maxResponseSize == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		buf := newResponseBuffer()
+		next.ServeHTTP(buf, r)
+		resp := buf.body.Bytes()
+		if buf.status == http.StatusOK {
+			resp = g.limitResponse(mergeResponses(resp, req.errors))
+		}
+
+		for key, values := range buf.header {
+			w.Header()[key] = values
+		}
+		writeHTTPResponse(w, buf.status, resp)
+	})
+}
+
+// mergeResponses appends the error responses of the rejected messages to the batch response.
+func mergeResponses(resp []byte, errs []json.RawMessage) []byte {
+	if len(errs) == 0 {
+		return resp
+	}
+
+	var batch []json.RawMessage
+	if len(bytes.TrimSpace(resp)) > 0 {
+		if err := json.Unmarshal(resp, &batch); err != nil {
+			return resp
+		}
+	}
+	merged, _ := json.Marshal(append(batch, errs...)) // the responses are valid json
+	return merged
+}
+
+func writeHTTPResponse(w http.ResponseWriter, status int, resp []byte) {
+	if len(resp) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp)))
+	w.WriteHeader(status)
+	_, _ = w.Write(resp) // #nosec G703
+}
+
+// responseBuffer buffers the response of the rpc server, so that it can be amended.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: make(http.Header), status: http.StatusOK}
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *responseBuffer) WriteHeader(status int) {
+	b.status = status
+}
+
+// clientLimiter holds the rate limiters of a client.
+type clientLimiter struct {
+	all      *tokenBucket // nil if the client requests are not rate limited
+	methods  map[string]*tokenBucket
+	lastSeen time.Time
+}
+
+// tokenBucket is a token bucket rate limiter, it is not safe for concurrent use.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// take refills the bucket and takes a token, it returns false if the bucket is empty.
+func (b *tokenBucket) take(now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// defaultBurst allows a second worth of requests at once.
+func defaultBurst(rate float64) int {
+	return int(math.Max(1, math.Ceil(rate)))
+}
+
+// matchRule looks up the rule of the method, the method rules take precedence over the namespace rules.
+func matchRule[T any](rules map[string]T, method string) (string, bool) {
+	if len(rules) == 0 {
+		return "", false
+	}
+	if _, ok := rules[method]; ok {
+		return method, true
+	}
+	if namespace, _, ok := strings.Cut(method, "_"); ok {
+		if _, ok := rules[namespace]; ok {
+			return namespace, true
+		}
+	}
+	return "", false
+}
+
+// clientIP returns the IP of the client making the request. It is the IP of the remote address
+// of the connection, unless it is a trusted proxy: the X-Forwarded-For header is then walked from
+// the right, skipping the trusted proxies, up to the first address set by an untrusted one. The
+// header is never read without trusted proxies, as any client can set it.
+func (g *RequestGuard) clientIP(r *http.Request) string {
+	ip := remoteIP(r.RemoteAddr)
+	if g == nil || len(g.trustedProxies) == 0 {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0 && g.isTrustedProxy(ip); i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+	}
+	return ip
+}
+
+func (g *RequestGuard) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range g.trustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP returns the IP of the remote address, or the address itself if it has no port.
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,245 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+const testClient = "127.0.0.1"
+
+func newTestGuard(t *testing.T, cfg config.JSONRPCLimitsConfig) *RequestGuard {
+	g, err := NewRequestGuard(cfg)
+	require.NoError(t, err)
+	return g
+}
+
+// responseIDs returns the ids and the error codes of the responses of a batch.
+func responseIDs(t *testing.T, resp []byte) map[string]int64 {
+	var batch []guardResponse
+	require.NoError(t, json.Unmarshal(resp, &batch))
+	ids := make(map[string]int64, len(batch))
+	for _, res := range batch {
+		var code int64
+		if res.Error != nil {
+			code = res.Error.Code.Int64()
+		}
+		ids[string(res.ID)] = code
+	}
+	return ids
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(1000, 0)
+	bucket := newTokenBucket(2, 3, now)
+
+	// the burst is taken at once
+	for i := 0; i < 3; i++ {
+		require.True(t, bucket.take(now))
+	}
+	require.False(t, bucket.take(now))
+
+	// the tokens are refilled at the rate
+	require.False(t, bucket.take(now.Add(400*time.Millisecond)))
+	require.True(t, bucket.take(now.Add(500*time.Millisecond)))
+	require.False(t, bucket.take(now.Add(500*time.Millisecond)))
+
+	// the refill is capped by the burst
+	later := now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, bucket.take(later))
+	}
+	require.False(t, bucket.take(later))
+
+	require.Equal(t, 1, defaultBurst(0.5))
+	require.Equal(t, 3, defaultBurst(2.5))
+}
+
+func TestRequestGuardAllowed(t *testing.T) {
+	testCases := []struct {
+		name    string
+		allow   []string
+		deny    []string
+		method  string
+		allowed bool
+	}{
+		{"no rules", nil, nil, "eth_call", true},
+		{"namespace denied", nil, []string{"debug"}, "debug_traceCall", false},
+		{"other namespace served", nil, []string{"debug"}, "eth_call", true},
+		{"method denied", nil, []string{"eth_sendRawTransaction"}, "eth_sendRawTransaction", false},
+		{"namespace allowed", []string{"eth"}, nil, "eth_call", true},
+		{"namespace not allowed", []string{"eth"}, nil, "debug_traceCall", false},
+		{"method allowed", []string{"debug_traceCall"}, nil, "debug_traceCall", true},
+		{"other method not allowed", []string{"debug_traceCall"}, nil, "debug_traceBlock", false},
+		{"deny takes precedence", []string{"eth"}, []string{"eth_sign"}, "eth_sign", false},
+		{"prefix is not a namespace", []string{"eth"}, nil, "ethx_call", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGuard(t, config.JSONRPCLimitsConfig{AllowList: tc.allow, DenyList: tc.deny})
+			require.Equal(t, tc.allowed, g.allowed(tc.method))
+		})
+	}
+}
+
+func TestRequestGuardRateLimit(t *testing.T) {
+	g := newTestGuard(t, config.JSONRPCLimitsConfig{
+		RateLimit:        100,
+		RateBurst:        3,
+		MethodRateLimits: []string{"debug=1", "eth_call=2"},
+	})
+
+	// the method rate takes precedence over the namespace rate
+	require.True(t, g.allowRate(testClient, "debug_traceCall"))
+	require.False(t, g.allowRate(testClient, "debug_traceBlock"))
+	require.True(t, g.allowRate(testClient, "eth_call"))
+	// the rejected requests still take from the client rate
+	require.False(t, g.allowRate(testClient, "eth_blockNumber"))
+
+	// the limits are tracked per client
+	require.True(t, g.allowRate("127.0.0.2", "debug_traceCall"))
+
+	unlimited := newTestGuard(t, config.JSONRPCLimitsConfig{})
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimited.allowRate(testClient, "eth_call"))
+	}
+}
+
+func TestRequestGuardCheck(t *testing.T) {
+	g := newTestGuard(t, config.JSONRPCLimitsConfig{DenyList: []string{"debug"}, MaxBatchSize: 4})
+
+	// single messages
+	req := g.check(testClient, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_call"}`))
+	require.NotNil(t, req.serve)
+	require.Nil(t, req.rejections())
+
+	req = g.check(testClient, []byte(`{"jsonrpc":"2.0","id":1,"method":"debug_traceCall"}`))
+	require.Nil(t, req.serve)
+	var res guardResponse
+	require.NoError(t, json.Unmarshal(req.rejections(), &res))
+	require.Equal(t, "1", string(res.ID))
+	require.Equal(t, int64(errCodeMethodNotFound), res.Error.Code.Int64())
+
+	// the rejected notifications are not answered
+	req = g.check(testClient, []byte(`{"jsonrpc":"2.0","method":"debug_traceCall"}`))
+	require.Nil(t, req.serve)
+	require.Nil(t, req.rejections())
+
+	// the invalid messages are left to the rpc server
+	req = g.check(testClient, []byte(`{"jsonrpc":"2.0","id":1}`))
+	require.NotNil(t, req.serve)
+
+	// the rejected messages are split from a batch
+	req = g.check(testClient, []byte(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call"},
+		{"jsonrpc":"2.0","id":2,"method":"debug_traceCall"},
+		{"jsonrpc":"2.0","method":"debug_traceCall"},
+		{"jsonrpc":"2.0","id":"3","method":"eth_blockNumber"}
+	]`))
+	var served []rpcMessage
+	require.NoError(t, json.Unmarshal(req.serve, &served))
+	require.Len(t, served, 2)
+	require.Equal(t, "1", string(served[0].ID))
+	require.Equal(t, `"3"`, string(served[1].ID))
+	require.Equal(t, map[string]int64{"2": errCodeMethodNotFound}, responseIDs(t, req.rejections()))
+
+	// the whole batch is served if nothing is rejected
+	batch := []byte(`[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_call"}]`)
+	req = g.check(testClient, batch)
+	require.Equal(t, batch, req.serve)
+	require.Nil(t, req.rejections())
+
+	// the batch exceeding the maximum size is rejected as a whole
+	req = g.check(testClient, []byte(`[{"id":1},{"id":2},{"id":3},{"id":4},{"id":5}]`))
+	require.Nil(t, req.serve)
+	require.NoError(t, json.Unmarshal(req.rejections(), &res))
+	require.Equal(t, "null", string(res.ID))
+	require.Equal(t, int64(errCodeInvalidRequest), res.Error.Code.Int64())
+}
+
+func TestRequestGuardLimitResponse(t *testing.T) {
+	g := newTestGuard(t, config.JSONRPCLimitsConfig{MaxResponseSize: 60})
+
+	small := []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	require.Equal(t, small, g.limitResponse(small))
+
+	large := []byte(`{"jsonrpc":"2.0","id":1,"result":"` + strings.Repeat("a", 100) + `"}`)
+	var res guardResponse
+	require.NoError(t, json.Unmarshal(g.limitResponse(large), &res))
+	require.Equal(t, "1", string(res.ID))
+	require.Equal(t, int64(errCodeResponseTooLarge), res.Error.Code.Int64())
+
+	// the responses of a batch are kept until the size is exceeded
+	batch := []byte(`[` + string(small) + `,` + string(large) + `]`)
+	require.Equal(t, map[string]int64{"1": errCodeResponseTooLarge}, responseIDs(t, g.limitResponse(batch)))
+	var limited []json.RawMessage
+	require.NoError(t, json.Unmarshal(g.limitResponse(batch), &limited))
+	require.Equal(t, string(small), string(limited[0]))
+}
+
+func TestRequestGuardHTTPHandler(t *testing.T) {
+	g := newTestGuard(t, config.JSONRPCLimitsConfig{DenyList: []string{"debug"}})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// answers the served messages of the batch
+		var batch []rpcMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		resp := make([]json.RawMessage, 0, len(batch))
+		for _, msg := range batch {
+			resp = append(resp, json.RawMessage(`{"jsonrpc":"2.0","id":`+string(msg.ID)+`,"result":"0x1"}`))
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	})
+
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"debug_traceCall"}]`
+	w := httptest.NewRecorder()
+	g.HTTPHandler(next).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	// the rejected messages are merged into the response of the batch
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, map[string]int64{"1": 0, "2": errCodeMethodNotFound}, responseIDs(t, w.Body.Bytes()))
+}
+
+func TestMergeResponses(t *testing.T) {
+	resp := []byte(`[{"jsonrpc":"2.0","id":"a","result":"0x1"}]`)
+	rejected := []json.RawMessage{json.RawMessage(`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"denied"}}`)}
+	require.Equal(t, map[string]int64{`"a"`: 0, "2": errCodeMethodNotFound}, responseIDs(t, mergeResponses(resp, rejected)))
+	require.Equal(t, map[string]int64{"2": errCodeMethodNotFound}, responseIDs(t, mergeResponses(nil, rejected)))
+}
+
+func TestRequestGuardClientIP(t *testing.T) {
+	newRequest := func(remoteAddr string, forwarded ...string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = remoteAddr
+		for _, header := range forwarded {
+			r.Header.Add("X-Forwarded-For", header)
+		}
+		return r
+	}
+
+	// the header is ignored without trusted proxies
+	g := newTestGuard(t, config.JSONRPCLimitsConfig{})
+	require.Equal(t, "10.0.0.1", g.clientIP(newRequest("10.0.0.1:1234", "1.2.3.4")))
+	var nilGuard *RequestGuard
+	require.Equal(t, "10.0.0.1", nilGuard.clientIP(newRequest("10.0.0.1:1234", "1.2.3.4")))
+
+	g = newTestGuard(t, config.JSONRPCLimitsConfig{TrustedProxies: []string{"127.0.0.1", "10.0.0.0/8"}})
+	// the client of a trusted proxy
+	require.Equal(t, "1.2.3.4", g.clientIP(newRequest("127.0.0.1:1234", "1.2.3.4")))
+	// the trusted proxies are skipped, the addresses before an untrusted one are spoofable
+	require.Equal(t, "1.2.3.4", g.clientIP(newRequest("127.0.0.1:1234", "6.6.6.6, 1.2.3.4", "10.0.0.2")))
+	// the untrusted remote address, the invalid and the missing headers
+	require.Equal(t, "5.6.7.8", g.clientIP(newRequest("5.6.7.8:1234", "1.2.3.4")))
+	require.Equal(t, "10.0.0.2", g.clientIP(newRequest("127.0.0.1:1234", "unknown, 10.0.0.2")))
+	require.Equal(t, "127.0.0.1", g.clientIP(newRequest("127.0.0.1:1234")))
+
+	_, err := NewRequestGuard(config.JSONRPCLimitsConfig{TrustedProxies: []string{"localhost"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	dbkeyring "github.com/99designs/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
+)
+
+const (
+	// keyringFileDirName is the directory of the file backend under the keyring dir
+	keyringFileDirName = "keyring-file"
+	// keyhashFileName is the file keeping the hash of the keyring passphrase
+	keyhashFileName = "keyhash"
+)
+
+// unlockedKeyring is the keyring opened with the passphrase of an unlocked account.
+type unlockedKeyring struct {
+	keyring.Keyring
+	// expiry locks the account when fired, nil if the account is unlocked until locked
+	expiry *time.Timer
+}
+
+// UnlockAccount checks the password of the account and keeps the key available for signing
+// without password for the duration, or until the account is locked if the duration is 0.
+// Only the keys of the file and os backends are protected by the keyring passphrase, the
+// accounts of the other backends are rejected unless json-rpc.allow-insecure-unlock is set.
+func (b *BackendImpl) UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error) {
+	kr, err := b.passphraseKeyring(address, password)
+	if err != nil {
+		return false, err
+	}
+
+	b.unlockedMu.Lock()
+	defer b.unlockedMu.Unlock()
+
+	if prev, ok := b.unlocked[address]; ok && prev.expiry != nil {
+		prev.expiry.Stop()
+	}
+
+	unlocked := &unlockedKeyring{Keyring: kr}
+	if duration > 0 {
+		unlocked.expiry = time.AfterFunc(duration, func() {
+			b.unlockedMu.Lock()
+			defer b.unlockedMu.Unlock()
+
+			// the account might have been unlocked again in the meantime
+			if b.unlocked[address] == unlocked {
+				delete(b.unlocked, address)
+			}
+		})
+	}
+	b.unlocked[address] = unlocked
+	return true, nil
+}
+
+// LockAccount drops the key of the account unlocked by UnlockAccount.
+func (b *BackendImpl) LockAccount(address common.Address) bool {
+	b.unlockedMu.Lock()
+	defer b.unlockedMu.Unlock()
+
+	if unlocked, ok := b.unlocked[address]; ok {
+		if unlocked.expiry != nil {
+			unlocked.expiry.Stop()
+		}
+		delete(b.unlocked, address)
+	}
+	return true
+}
+
+// signingKeyring returns the keyring to sign with the key of the account, which is the keyring
+// unlocked for the account if any, or the keyring of the node otherwise.
+func (b *BackendImpl) signingKeyring(address common.Address) keyring.Keyring {
+	b.unlockedMu.Lock()
+	defer b.unlockedMu.Unlock()
+
+	if unlocked, ok := b.unlocked[address]; ok {
+		return unlocked
+	}
+	return b.clientCtx.Keyring
+}
+
+// passphraseKeyring opens the keyring of the node with the passphrase, and checks that it
+// holds the eth_secp256k1 key of the account. The keyring of the node is returned as it is
+// for the backends not protected by a passphrase, if the insecure unlock is allowed.
+func (b *BackendImpl) passphraseKeyring(address common.Address, password string) (keyring.Keyring, error) {
+	kr := b.clientCtx.Keyring
+	if kr == nil {
+		return nil, errors.New("keyring of the node is not available")
+	}
+
+	switch backend := kr.Backend(); backend {
+	case keyring.BackendFile, keyring.BackendOS:
+		dir := b.clientCtx.KeyringDir
+		if dir == "" {
+			dir = b.clientCtx.HomeDir
+		}
+
+		// same configs as the cosmos keyring, except that the prompt answers the password
+		var cfg dbkeyring.Config
+		if backend == keyring.BackendFile {
+			fileDir := filepath.Join(dir, keyringFileDirName)
+			cfg = dbkeyring.Config{
+				AllowedBackends:  []dbkeyring.BackendType{dbkeyring.FileBackend},
+				ServiceName:      sdktypes.KeyringServiceName(),
+				FileDir:          fileDir,
+				FilePasswordFunc: passphrasePrompt(fileDir, password),
+			}
+		} else {
+			cfg = dbkeyring.Config{
+				ServiceName:              sdktypes.KeyringServiceName(),
+				FileDir:                  dir,
+				KeychainTrustApplication: true,
+				FilePasswordFunc:         passphrasePrompt(dir, password),
+			}
+		}
+
+		db, err := dbkeyring.Open(cfg)
+		if err != nil {
+			return nil, err
+		}
+		kr = keyring.NewInMemoryWithKeyring(db, b.clientCtx.Codec, b.clientCtx.KeyringOptions...)
+	default:
+		// any password would unlock the accounts of the backend
+		if !b.cfg.AppCfg.JSONRPC.AllowInsecureUnlock {
+			return nil, fmt.Errorf("account unlock with the %s keyring backend is not protected by a passphrase, "+
+				"set json-rpc.allow-insecure-unlock to allow it for development", backend)
+		}
+	}
+
+	if err := checkEthKey(kr, address); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// passphrasePrompt returns the prompt of the file keyring which answers the password, the
+// password is checked against the passphrase hash stored along with the keys.
+func passphrasePrompt(dir, password string) dbkeyring.PromptFunc {
+	return func(string) (string, error) {
+		keyhash, err := os.ReadFile(filepath.Join(dir, keyhashFileName))
+		switch {
+		case os.IsNotExist(err):
+			// the keys fail to be decrypted if the password is wrong
+			return password, nil
+		case err != nil:
+			return "", err
+		}
+
+		if err := bcrypt.CompareHashAndPassword(keyhash, []byte(password)); err != nil {
+			return "", keystore.ErrDecrypt
+		}
+		return password, nil
+	}
+}
+
+// checkEthKey checks that the keyring holds the eth_secp256k1 key of the account.
+func checkEthKey(kr keyring.Keyring, address common.Address) error {
+	record, err := kr.KeyByAddress(sdktypes.AccAddress(address.Bytes()))
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return keystore.ErrDecrypt
+		}
+		return fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("key %s of %s is not an %s key", record.Name, address, ethsecp256k1.KeyType)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkcryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/artela-network/artela/ethereum/crypto/codec"
+	"github.com/artela-network/artela/ethereum/crypto/hd"
+	cryptokeyring "github.com/artela-network/artela/ethereum/crypto/keyring"
+	"github.com/artela-network/artela/ethereum/server/config"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const testPassword = "password"
+
+// newTestKeyringBackend returns a backend with a keyring of the backend holding one
+// eth_secp256k1 key, the file keyring is protected by testPassword.
+func newTestKeyringBackend(t *testing.T, backend string, allowInsecureUnlock bool) (*BackendImpl, common.Address) {
+	registry := codectypes.NewInterfaceRegistry()
+	sdkcryptocodec.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	dir := t.TempDir()
+	// the passphrase is entered twice when the file keyring is created
+	input := strings.NewReader(testPassword + "\n" + testPassword + "\n")
+	kr, err := keyring.New(sdktypes.KeyringServiceName(), backend, dir, input, cdc, cryptokeyring.Option())
+	require.NoError(t, err)
+
+	record, _, err := kr.NewMnemonic("key", keyring.English, artelatypes.BIP44HDPath,
+		keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	appCfg := config.DefaultConfig()
+	appCfg.JSONRPC.AllowInsecureUnlock = allowInsecureUnlock
+	b := &BackendImpl{
+		clientCtx: client.Context{}.
+			WithCodec(cdc).
+			WithKeyring(kr).
+			WithKeyringDir(dir).
+			WithKeyringOptions(cryptokeyring.Option()),
+		cfg:      &Config{AppCfg: appCfg},
+		unlocked: make(map[common.Address]*unlockedKeyring),
+	}
+	return b, common.BytesToAddress(addr)
+}
+
+func (b *BackendImpl) isUnlocked(address common.Address) bool {
+	b.unlockedMu.Lock()
+	defer b.unlockedMu.Unlock()
+
+	_, ok := b.unlocked[address]
+	return ok
+}
+
+func TestUnlockAccount(t *testing.T) {
+	b, address := newTestKeyringBackend(t, keyring.BackendFile, false)
+
+	// the wrong password is rejected
+	ok, err := b.UnlockAccount(address, "wrong", 0)
+	require.ErrorIs(t, err, keystore.ErrDecrypt)
+	require.False(t, ok)
+	require.False(t, b.isUnlocked(address))
+
+	// the unknown account is rejected
+	_, err = b.UnlockAccount(common.HexToAddress("0x1"), testPassword, 0)
+	require.ErrorContains(t, err, keystore.ErrNoMatch.Error())
+
+	// the account is unlocked until locked
+	ok, err = b.UnlockAccount(address, testPassword, 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, b.isUnlocked(address))
+	require.IsType(t, &unlockedKeyring{}, b.signingKeyring(address))
+
+	require.True(t, b.LockAccount(address))
+	require.False(t, b.isUnlocked(address))
+	_, ok = b.signingKeyring(address).(*unlockedKeyring)
+	require.False(t, ok)
+}
+
+func TestUnlockAccountExpiry(t *testing.T) {
+	b, address := newTestKeyringBackend(t, keyring.BackendFile, false)
+
+	ok, err := b.UnlockAccount(address, testPassword, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, b.isUnlocked(address))
+
+	// the account is locked when the duration is elapsed
+	require.Eventually(t, func() bool { return !b.isUnlocked(address) }, 5*time.Second, 10*time.Millisecond)
+
+	// the expiry of the previous unlock does not lock the account unlocked again
+	_, err = b.UnlockAccount(address, testPassword, 50*time.Millisecond)
+	require.NoError(t, err)
+	_, err = b.UnlockAccount(address, testPassword, 0)
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	require.True(t, b.isUnlocked(address))
+}
+
+func TestUnlockAccountInsecureBackend(t *testing.T) {
+	b, address := newTestKeyringBackend(t, keyring.BackendTest, false)
+
+	// the test backend is not protected by a passphrase
+	ok, err := b.UnlockAccount(address, testPassword, 0)
+	require.ErrorContains(t, err, "allow-insecure-unlock")
+	require.False(t, ok)
+	require.False(t, b.isUnlocked(address))
+
+	// any password unlocks the account once allowed
+	b, address = newTestKeyringBackend(t, keyring.BackendTest, true)
+	ok, err = b.UnlockAccount(address, "wrong", 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, b.isUnlocked(address))
+}
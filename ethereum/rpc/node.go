@@ -1,7 +1,10 @@
 package rpc
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/artela-network/artela/ethereum/rpc/types"
 )
@@ -9,23 +12,63 @@ import (
 // Node Wrapers Ethereum Node
 type Node struct {
 	*node.Node
+
+	guard *RequestGuard
+	wsSrv *rpc.Server
 }
 
 // Node is an implement of NetworkingStack
 var _ types.NetworkingStack = (*Node)(nil)
 
-// Node creates a new NetworkingStack instance.
-func NewNode(config *node.Config) (types.NetworkingStack, error) {
+// Node creates a new NetworkingStack instance, the http requests are served through the guard if not nil.
+func NewNode(config *node.Config, guard *RequestGuard) (types.NetworkingStack, error) {
 	node, err := node.New(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Node{
-		Node: node,
+		Node:  node,
+		guard: guard,
 	}, nil
 }
 
+// RegisterAPIs registers the apis to the networking stack. The websocket requests are served
+// by a dedicated rpc server with the apis of the websocket modules. With a request guard, the
+// http requests are served by a dedicated rpc server behind the guard, which takes over the
+// root path of the http server.
+func (n *Node) RegisterAPIs(apis []rpc.API) {
+	n.Node.RegisterAPIs(apis)
+
+	cfg := n.Node.Config()
+	wsSrv := rpc.NewServer()
+	if err := node.RegisterApis(apis, cfg.WSModules, wsSrv); err != nil {
+		cfg.Logger.Error("failed to register websocket apis", "error", err)
+	} else {
+		n.wsSrv = wsSrv
+	}
+
+	if n.guard == nil {
+		return
+	}
+
+	srv := rpc.NewServer()
+	if err := node.RegisterApis(apis, cfg.HTTPModules, srv); err != nil {
+		cfg.Logger.Error("failed to register guarded http apis", "error", err)
+		return
+	}
+	handler := node.NewHTTPHandlerStack(n.guard.HTTPHandler(srv), cfg.HTTPCors, cfg.HTTPVirtualHosts, nil)
+	n.Node.RegisterHandler("JSON-RPC", "/", handler)
+}
+
+// WSHandler returns the rpc server of the websocket connections.
+func (n *Node) WSHandler() (*rpc.Server, error) {
+	if n.wsSrv == nil {
+		return nil, errors.New("websocket apis are not registered")
+	}
+	return n.wsSrv, nil
+}
+
 // ExtRPCEnabled returns whether or not the external RPC service is enabled.
 func (n *Node) ExtRPCEnabled() bool {
 	return n.Node.Config().ExtRPCEnabled()
@@ -42,8 +85,8 @@ func DefaultGethNodeConfig() *node.Config {
 	nodeCfg.P2P.NoDiscovery = true
 	nodeCfg.P2P.MaxPeers = 0
 	nodeCfg.Name = clientIdentifier
-	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "txpool", "debug")
-	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth")
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "txpool", "debug", "aspect")
+	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth", "txpool", "aspect")
 	nodeCfg.HTTPHost = "0.0.0.0"
 	nodeCfg.WSHost = ""
 	nodeCfg.WSOrigins = []string{"*"}
@@ -53,3 +96,27 @@ func DefaultGethNodeConfig() *node.Config {
 	nodeCfg.GraphQLVirtualHosts = []string{"*"}
 	return &nodeCfg
 }
+
+// EnableAPIs adds the opt-in apis listed in the enabled json-rpc apis to the modules of the node.
+// The debug apis are always served over http, and over websocket only if listed, as the websocket
+// modules are served publicly by default. The personal apis are served over both only if listed.
+func EnableAPIs(nodeCfg *node.Config, apis []string) {
+	for _, api := range apis {
+		switch api {
+		case "debug":
+			nodeCfg.WSModules = appendModule(nodeCfg.WSModules, api)
+		case "personal":
+			nodeCfg.HTTPModules = appendModule(nodeCfg.HTTPModules, api)
+			nodeCfg.WSModules = appendModule(nodeCfg.WSModules, api)
+		}
+	}
+}
+
+func appendModule(modules []string, module string) []string {
+	for _, m := range modules {
+		if m == module {
+			return modules
+		}
+	}
+	return append(modules, module)
+}
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+)
+
+type testNamespaceService struct{}
+
+func (testNamespaceService) Echo(s string) string { return s }
+
+// newTestNodeModules registers an api in each namespace served by the node, and returns
+// the namespaces served over websocket.
+func newTestNodeModules(t *testing.T, apis []string) map[string]string {
+	nodeCfg := DefaultGethNodeConfig()
+	nodeCfg.DataDir = ""
+	EnableAPIs(nodeCfg, apis)
+
+	stack, err := NewNode(nodeCfg, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = stack.Close() })
+
+	var namespaces []rpc.API
+	for _, namespace := range []string{"eth", "net", "web3", "txpool", "debug", "personal", "aspect"} {
+		namespaces = append(namespaces, rpc.API{Namespace: namespace, Service: testNamespaceService{}})
+	}
+	stack.RegisterAPIs(namespaces)
+
+	wsSrv, err := stack.WSHandler()
+	require.NoError(t, err)
+	client := rpc.DialInProc(wsSrv)
+	t.Cleanup(client.Close)
+
+	modules, err := client.SupportedModules()
+	require.NoError(t, err)
+	return modules
+}
+
+func TestWSModules(t *testing.T) {
+	modules := newTestNodeModules(t, []string{"eth", "net", "web3"})
+	for _, namespace := range []string{"eth", "net", "web3", "txpool", "aspect"} {
+		require.Contains(t, modules, namespace)
+	}
+	require.NotContains(t, modules, "debug")
+	require.NotContains(t, modules, "personal")
+
+	// the debug and personal apis are served once enabled in the json-rpc apis
+	modules = newTestNodeModules(t, []string{"eth", "debug", "personal"})
+	require.Contains(t, modules, "debug")
+	require.Contains(t, modules, "personal")
+	require.Contains(t, modules, "txpool")
+}
+
+// newTestPersonalNode starts a node serving the personal apis of the backend over http.
+func newTestPersonalNode(t *testing.T, b *BackendImpl, apis []string) *Node {
+	nodeCfg := DefaultGethNodeConfig()
+	nodeCfg.DataDir = ""
+	nodeCfg.HTTPHost = "127.0.0.1"
+	nodeCfg.HTTPPort = 0
+	nodeCfg.P2P.ListenAddr = ""
+	EnableAPIs(nodeCfg, apis)
+
+	stack, err := NewNode(nodeCfg, nil)
+	require.NoError(t, err)
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "personal",
+		Service:   ethapi.NewPersonalAccountAPI(b, log.Root(), new(ethapi.AddrLocker)),
+	}})
+	require.NoError(t, stack.Start())
+	t.Cleanup(func() { _ = stack.Close() })
+	return stack.(*Node)
+}
+
+func TestPersonalUnlockAccount(t *testing.T) {
+	b, address := newTestKeyringBackend(t, keyring.BackendFile, false)
+	stack := newTestPersonalNode(t, b, []string{"eth", "personal"})
+
+	for _, dial := range []func() (*rpc.Client, error){
+		func() (*rpc.Client, error) { return rpc.Dial(stack.HTTPEndpoint()) },
+		func() (*rpc.Client, error) {
+			wsSrv, err := stack.WSHandler()
+			if err != nil {
+				return nil, err
+			}
+			return rpc.DialInProc(wsSrv), nil
+		},
+	} {
+		client, err := dial()
+		require.NoError(t, err)
+
+		var ok bool
+		require.NoError(t, client.Call(&ok, "personal_unlockAccount", address, testPassword, 0))
+		require.True(t, ok)
+		require.True(t, b.isUnlocked(address))
+		require.NoError(t, client.Call(&ok, "personal_lockAccount", address))
+		require.True(t, ok)
+		require.False(t, b.isUnlocked(address))
+		client.Close()
+	}
+
+	// the personal apis are not served unless enabled
+	b, address = newTestKeyringBackend(t, keyring.BackendFile, false)
+	stack = newTestPersonalNode(t, b, []string{"eth"})
+	client, err := rpc.Dial(stack.HTTPEndpoint())
+	require.NoError(t, err)
+	defer client.Close()
+	var ok bool
+	require.ErrorContains(t, client.Call(&ok, "personal_unlockAccount", address, testPassword, 0), "does not exist")
+	require.False(t, b.isUnlocked(address))
+}
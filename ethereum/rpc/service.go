@@ -1,13 +1,21 @@
 package rpc
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/rpc/filters"
 	"github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/server/config"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
 )
 
 type ArtelaService struct {
@@ -17,6 +25,10 @@ type ArtelaService struct {
 	stack     types.NetworkingStack
 	backend   *BackendImpl
 	logger    log.Logger
+
+	// events serves the filter apis from the cometbft events of wsClient
+	events   *filters.EventSystem
+	wsServer WebsocketsServer
 }
 
 func NewArtelaService(
@@ -26,6 +38,9 @@ func NewArtelaService(
 	cfg *Config,
 	stack types.NetworkingStack,
 	logger log.Logger,
+	indexer artelatypes.EVMTxIndexer,
+	bloomIndexer artelatypes.EVMBloomIndexer,
+	evmMempool artelatypes.EVMMempool,
 ) *ArtelaService {
 	art := &ArtelaService{
 		cfg:       cfg,
@@ -33,14 +48,31 @@ func NewArtelaService(
 		clientCtx: clientCtx,
 		wsClient:  wsClient,
 		logger:    logger,
+		events:    filters.NewEventSystem(logger, wsClient),
 	}
 
-	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger)
+	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, indexer, bloomIndexer, evmMempool)
 	return art
 }
 
+// Backend returns the backend serving the JSON-RPC apis.
+func (art *ArtelaService) Backend() *BackendImpl {
+	return art.backend
+}
+
 func (art *ArtelaService) APIs() []rpc.API {
-	return GetAPIs(art.clientCtx, art.wsClient, art.logger, art.backend)
+	return GetAPIs(art.clientCtx, art.events, art.logger, art.backend)
+}
+
+// SetWebsocketsServer sets the websocket server serving the apis, it is stopped along with the service.
+func (art *ArtelaService) SetWebsocketsServer(wsServer WebsocketsServer) {
+	art.wsServer = wsServer
+}
+
+// SetMempool sets the mempool of the node running in process, the txpool apis read the
+// whole mempool from it instead of the first page of the unconfirmed_txs query.
+func (art *ArtelaService) SetMempool(mempool TxReaper) {
+	art.backend.tmMempool = mempool
 }
 
 // Start start the ethereum JsonRPC service
@@ -52,9 +84,37 @@ func (art *ArtelaService) Start() error {
 	return art.stack.Start()
 }
 
+// Shutdown stops the ethereum JsonRPC service. The websocket subscriptions are closed and
+// the in-flight requests are drained before the networking stack is closed, then the
+// event system and the cometbft websocket client are released.
 func (art *ArtelaService) Shutdown() error {
-	// TODO shut down
-	return nil
+	// a zero timeout, e.g. left unset in an older app.toml, would close the connections at once
+	timeout := art.cfg.AppCfg.JSONRPC.ShutdownTimeout
+	if timeout == 0 {
+		timeout = config.DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var errs []error
+	if art.wsServer != nil {
+		if err := art.wsServer.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop websocket server: %w", err))
+		}
+	}
+
+	if err := art.stack.Close(); err != nil && !errors.Is(err, node.ErrNodeStopped) {
+		errs = append(errs, fmt.Errorf("failed to close networking stack: %w", err))
+	}
+
+	art.events.Stop()
+	if art.wsClient != nil && art.wsClient.IsRunning() {
+		if err := art.wsClient.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop cometbft websocket client: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // RegisterAPIs register apis and create graphql instance.
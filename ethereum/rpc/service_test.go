@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tmwsclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/rpc/filters"
+	"github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+type testShutdownStack struct {
+	types.NetworkingStack
+
+	close func() error
+}
+
+func (s *testShutdownStack) Close() error { return s.close() }
+
+type testShutdownWSServer struct {
+	stop func(ctx context.Context) error
+}
+
+func (s *testShutdownWSServer) Start()                         {}
+func (s *testShutdownWSServer) Stop(ctx context.Context) error { return s.stop(ctx) }
+
+func isDone(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func newTestShutdownService(t *testing.T, stack types.NetworkingStack, wsServer WebsocketsServer) (*ArtelaService, *tmwsclient.WSClient) {
+	wsClient := newTestCometWSClient(t)
+	cfg := DefaultConfig()
+	cfg.AppCfg = config.DefaultConfig()
+	// the timeout left unset falls back to the default one
+	cfg.AppCfg.JSONRPC.ShutdownTimeout = 0
+
+	art := &ArtelaService{
+		cfg:      cfg,
+		stack:    stack,
+		wsClient: wsClient,
+		logger:   log.Root(),
+		events:   filters.NewEventSystem(log.Root(), wsClient),
+	}
+	art.SetWebsocketsServer(wsServer)
+	return art, wsClient
+}
+
+func TestShutdownOrder(t *testing.T) {
+	var (
+		order     []string
+		art       *ArtelaService
+		wsClient  *tmwsclient.WSClient
+		wsStopped bool
+	)
+
+	wsServer := &testShutdownWSServer{stop: func(ctx context.Context) error {
+		// the websocket connections are drained first, within the shutdown timeout
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(config.DefaultShutdownTimeout), deadline, time.Second)
+		require.False(t, isDone(art.events.Done()))
+		require.True(t, wsClient.IsRunning())
+		order = append(order, "websockets")
+		wsStopped = true
+		return nil
+	}}
+	stack := &testShutdownStack{close: func() error {
+		// the http requests are drained while the events are still delivered
+		require.True(t, wsStopped)
+		require.False(t, isDone(art.events.Done()))
+		require.True(t, wsClient.IsRunning())
+		order = append(order, "stack")
+		return nil
+	}}
+	art, wsClient = newTestShutdownService(t, stack, wsServer)
+
+	require.NoError(t, art.Shutdown())
+	require.Equal(t, []string{"websockets", "stack"}, order)
+	// the event system and the cometbft client are released last
+	require.True(t, isDone(art.events.Done()))
+	require.False(t, wsClient.IsRunning())
+}
+
+func TestShutdownErrors(t *testing.T) {
+	wsErr := errors.New("websockets busy")
+	stackErr := errors.New("stack busy")
+
+	// the shutdown goes on after a failed step, and reports all the errors
+	stack := &testShutdownStack{close: func() error { return stackErr }}
+	wsServer := &testShutdownWSServer{stop: func(context.Context) error { return wsErr }}
+	art, wsClient := newTestShutdownService(t, stack, wsServer)
+
+	err := art.Shutdown()
+	require.ErrorIs(t, err, wsErr)
+	require.ErrorIs(t, err, stackErr)
+	require.True(t, isDone(art.events.Done()))
+	require.False(t, wsClient.IsRunning())
+
+	// the stack already stopped is not an error
+	stack = &testShutdownStack{close: func() error { return node.ErrNodeStopped }}
+	wsServer = &testShutdownWSServer{stop: func(context.Context) error { return nil }}
+	art, _ = newTestShutdownService(t, stack, wsServer)
+	require.NoError(t, art.Shutdown())
+}
@@ -11,8 +11,10 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	evmtxs "github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/txs/support"
@@ -143,9 +145,7 @@ func (b *BackendImpl) TraceBlock(height rpc.BlockNumber,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtxs.TxTraceResult, error) {
 	txs := block.Block.Txs
-	txsLength := len(txs)
-
-	if txsLength == 0 {
+	if len(txs) == 0 {
 		// If there are no transactions return empty array
 		return []*evmtxs.TxTraceResult{}, nil
 	}
@@ -170,6 +170,45 @@ func (b *BackendImpl) TraceBlock(height rpc.BlockNumber,
 		}
 	}
 
+	return b.traceBlockMessages(height, config, block, txsMessages)
+}
+
+// TraceEthBlock traces the transactions of the given ethereum block on top of the state
+// at the beginning of the block with the same height, the block doesn't need to be canonical.
+func (b *BackendImpl) TraceEthBlock(block *ethtypes.Block, config *support.TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+	if block.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := b.CosmosBlockByNumber(rpc.BlockNumber(block.Number().Int64()))
+	if err != nil {
+		b.logger.Debug("block not found", "height", block.NumberU64())
+		return nil, err
+	}
+
+	if len(block.Transactions()) == 0 {
+		return []*evmtxs.TxTraceResult{}, nil
+	}
+
+	txsMessages := make([]*evmtxs.MsgEthereumTx, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		ethMessage := &evmtxs.MsgEthereumTx{}
+		if err := ethMessage.FromEthereumTx(tx); err != nil {
+			return nil, err
+		}
+		txsMessages = append(txsMessages, ethMessage)
+	}
+
+	return b.traceBlockMessages(rpc.BlockNumber(resBlock.Block.Height), config, resBlock, txsMessages)
+}
+
+// traceBlockMessages traces the given messages one after another on top of the state
+// at the beginning of the block.
+func (b *BackendImpl) traceBlockMessages(height rpc.BlockNumber,
+	config *support.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evmtxs.MsgEthereumTx,
+) ([]*evmtxs.TxTraceResult, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -203,10 +242,67 @@ func (b *BackendImpl) TraceBlock(height rpc.BlockNumber,
 		return nil, err
 	}
 
-	decodedResults := make([]*evmtxs.TxTraceResult, txsLength)
+	decodedResults := make([]*evmtxs.TxTraceResult, len(txsMessages))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
 
 	return decodedResults, nil
 }
+
+// TraceCall returns the structured logs created during the execution of the given call
+// on top of the state of the requested block, the state and block overrides are applied before the execution.
+func (b *BackendImpl) TraceCall(
+	args ethapi.TransactionArgs,
+	blockNrOrHash rpc.BlockNumberOrHash,
+	config *support.TraceConfig,
+	overrides *ethapi.StateOverride,
+	blockOverrides *ethapi.BlockOverrides,
+) (interface{}, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	traceCallRequest := evmtxs.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		TraceConfig:     config,
+	}
+	if overrides != nil {
+		if traceCallRequest.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if traceCallRequest.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNum.Int64()), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
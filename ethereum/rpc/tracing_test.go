@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// testTraceQueryClient records the trace call requests.
+type testTraceQueryClient struct {
+	txs.QueryClient
+	requests []*txs.QueryTraceCallRequest
+}
+
+func (c *testTraceQueryClient) TraceCall(_ context.Context, req *txs.QueryTraceCallRequest, _ ...grpc.CallOption) (*txs.QueryTraceCallResponse, error) {
+	c.requests = append(c.requests, req)
+	return &txs.QueryTraceCallResponse{Data: []byte(`{"gas": 21000}`)}, nil
+}
+
+func TestTraceCall(t *testing.T) {
+	proposer := tmbytes.HexBytes(common.HexToAddress("0x1").Bytes())
+	queryClient := &testTraceQueryClient{}
+	b := &BackendImpl{
+		ctx:         context.Background(),
+		clientCtx:   client.Context{}.WithClient(testCometClient{proposer: proposer}),
+		queryClient: &rpctypes.QueryClient{QueryClient: queryClient},
+		cfg:         &Config{RPCGasCap: 25000000},
+		chainID:     big.NewInt(11822),
+	}
+
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	args := ethapi.TransactionArgs{From: &from}
+	blockNum := rpc.BlockNumber(5)
+	nonce := hexutil.Uint64(5)
+	overrides := ethapi.StateOverride{from: ethapi.OverrideAccount{Nonce: &nonce}}
+	blockOverrides := ethapi.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0x10))}
+	config := &support.TraceConfig{Tracer: "callTracer"}
+
+	res, err := b.TraceCall(args, rpc.BlockNumberOrHash{BlockNumber: &blockNum}, config, &overrides, &blockOverrides)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"gas": float64(21000)}, res)
+
+	// the overrides are sent to the evm module in their json encoding
+	require.Len(t, queryClient.requests, 1)
+	req := queryClient.requests[0]
+	require.Equal(t, config, req.TraceConfig)
+	require.Equal(t, uint64(25000000), req.GasCap)
+	require.Equal(t, int64(11822), req.ChainId)
+	var reqOverrides ethapi.StateOverride
+	require.NoError(t, json.Unmarshal(req.Overrides, &reqOverrides))
+	require.Equal(t, overrides, reqOverrides)
+	var reqBlockOverrides ethapi.BlockOverrides
+	require.NoError(t, json.Unmarshal(req.BlockOverrides, &reqBlockOverrides))
+	require.Equal(t, blockOverrides, reqBlockOverrides)
+
+	// no overrides
+	_, err = b.TraceCall(args, rpc.BlockNumberOrHash{BlockNumber: &blockNum}, nil, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, queryClient.requests[1].Overrides)
+	require.Nil(t, queryClient.requests[1].BlockOverrides)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -24,6 +25,7 @@ import (
 	"github.com/artela-network/artela/ethereum/rpc/utils"
 	"github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
 
@@ -147,34 +149,183 @@ func (b *BackendImpl) GetTransaction(ctx context.Context, txHash common.Hash) (*
 
 func (b *BackendImpl) GetPoolTransactions() (ethtypes.Transactions, error) {
 	b.logger.Debug("called eth.rpc.backend.GetPoolTransactions")
-	return nil, errors.New("GetPoolTransactions is not implemented")
+	poolTxs, err := b.poolEthTxsBySender()
+	if err != nil {
+		return nil, err
+	}
+
+	var result ethtypes.Transactions
+	for _, senderTxs := range poolTxs {
+		result = append(result, senderTxs...)
+	}
+	return result, nil
 }
 
 func (b *BackendImpl) GetPoolTransaction(txHash common.Hash) *ethtypes.Transaction {
-	b.logger.Error("GetPoolTransaction is not implemented")
+	poolTxs, err := b.poolEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("GetPoolTransaction failed", "error", err)
+		return nil
+	}
+
+	for _, senderTxs := range poolTxs {
+		for _, tx := range senderTxs {
+			if tx.Hash() == txHash {
+				return tx
+			}
+		}
+	}
 	return nil
 }
 
+// GetPoolNonce returns the next nonce of the account, taking the executable txs in mempool into account.
 func (b *BackendImpl) GetPoolNonce(_ context.Context, addr common.Address) (uint64, error) {
-	return 0, errors.New("GetPoolNonce is not implemented")
+	return b.getAccountNonce(addr, true, 0)
 }
 
+// pendingNonce returns the next nonce of the account after the executable txs in mempool,
+// the nonce is the committed nonce of the account.
+func (b *BackendImpl) pendingNonce(addr common.Address, nonce uint64) uint64 {
+	// the app-side mempool keeps the queued txs as well, only the executable ones are counted
+	if b.evmMempool != nil {
+		return b.evmMempool.PendingNonce(addr, nonce)
+	}
+
+	poolTxs, err := b.poolEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("failed to get pending txs of account", "account", addr.Hex(), "error", err)
+		return nonce
+	}
+
+	pending, _ := splitPendingQueued(nonce, poolTxs[addr])
+	return nonce + uint64(len(pending))
+}
+
+// Stats returns the number of pending and queued txs in the mempool.
 func (b *BackendImpl) Stats() (int, int) {
-	b.logger.Error("Stats is not implemented")
-	return 0, 0
+	pending, queued := b.TxPoolContent()
+
+	pendingCount, queuedCount := 0, 0
+	for _, txs := range pending {
+		pendingCount += len(txs)
+	}
+	for _, txs := range queued {
+		queuedCount += len(txs)
+	}
+	return pendingCount, queuedCount
 }
 
+// TxPoolContent returns the pending and queued txs in the mempool grouped by sender.
 func (b *BackendImpl) TxPoolContent() (
 	map[common.Address]ethtypes.Transactions, map[common.Address]ethtypes.Transactions,
 ) {
-	b.logger.Error("TxPoolContent is not implemented")
-	return nil, nil
+	pending := make(map[common.Address]ethtypes.Transactions)
+	queued := make(map[common.Address]ethtypes.Transactions)
+
+	poolTxs, err := b.poolEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("TxPoolContent failed", "error", err)
+		return pending, queued
+	}
+
+	for sender, senderTxs := range poolTxs {
+		nonce, err := b.getAccountNonce(sender, false, 0)
+		if err != nil {
+			b.logger.Debug("TxPoolContent failed to get account nonce", "account", sender.Hex(), "error", err)
+			continue
+		}
+
+		senderPending, senderQueued := splitPendingQueued(nonce, senderTxs)
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+	return pending, queued
 }
 
+// TxPoolContentFrom returns the pending and queued txs of the given account in the mempool.
 func (b *BackendImpl) TxPoolContentFrom(addr common.Address) (
 	ethtypes.Transactions, ethtypes.Transactions,
 ) {
-	return nil, nil
+	poolTxs, err := b.poolEthTxsBySender()
+	if err != nil {
+		b.logger.Debug("TxPoolContentFrom failed", "error", err)
+		return nil, nil
+	}
+
+	nonce, err := b.getAccountNonce(addr, false, 0)
+	if err != nil {
+		b.logger.Debug("TxPoolContentFrom failed to get account nonce", "account", addr.Hex(), "error", err)
+		return nil, nil
+	}
+
+	return splitPendingQueued(nonce, poolTxs[addr])
+}
+
+// poolEthTxsBySender decodes the ethereum txs in the mempool and groups them by sender,
+// the txs of each sender are sorted by nonce. The txs are read from the app-side mempool
+// if enabled, where the replaced txs are already dropped.
+func (b *BackendImpl) poolEthTxsBySender() (map[common.Address]ethtypes.Transactions, error) {
+	if b.evmMempool != nil {
+		return b.evmMempool.EthTxsBySender(), nil
+	}
+
+	unconfirmedTxs, err := b.unconfirmedTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address]ethtypes.Transactions)
+	for _, txBz := range unconfirmedTxs {
+		ethMsgs, err := rpctypes.RawTxToEthTx(b.clientCtx, txBz)
+		if err != nil {
+			// not ethereum tx
+			continue
+		}
+
+		for _, ethMsg := range ethMsgs {
+			sender, err := b.GetSender(ethMsg, b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err)
+				continue
+			}
+			result[sender] = append(result[sender], ethMsg.AsTransaction())
+		}
+	}
+
+	for _, senderTxs := range result {
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce() < senderTxs[j].Nonce()
+		})
+	}
+	return result, nil
+}
+
+// splitPendingQueued splits the nonce sorted txs of one sender into pending and queued txs,
+// txs with continuous nonces starting from the account nonce are executable and thus pending,
+// the rest are queued. Txs with nonce lower than the account nonce are stale and skipped, and
+// so are the later txs with the same nonce as a previous one, which would fail once the first
+// one is executed.
+func splitPendingQueued(nonce uint64, senderTxs ethtypes.Transactions) (ethtypes.Transactions, ethtypes.Transactions) {
+	var pending, queued ethtypes.Transactions
+	next := nonce
+	for i, tx := range senderTxs {
+		switch {
+		case tx.Nonce() < nonce:
+			continue
+		case i > 0 && tx.Nonce() == senderTxs[i-1].Nonce():
+			continue
+		case tx.Nonce() == next:
+			pending = append(pending, tx)
+			next++
+		default:
+			queued = append(queued, tx)
+		}
+	}
+	return pending, queued
 }
 
 func (b *BackendImpl) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
@@ -206,9 +357,14 @@ func (b *BackendImpl) Engine() consensus.Engine {
 }
 
 func (b *BackendImpl) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
-	// if b.indexer != nil {
-	// 	return b.indexer.GetByTxHash(hash)
-	// }
+	if b.indexer != nil {
+		txResult, err := b.indexer.GetByTxHash(hash)
+		if err == nil {
+			return txResult, nil
+		}
+		// the block containing the tx may not be indexed yet
+		b.logger.Debug("custom indexer lookup failed, fallback to tendermint tx indexer", "hash", hash.Hex(), "error", err)
+	}
 
 	// fallback to tendermint tx indexer
 	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
@@ -310,6 +466,16 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		receipt["logs"] = [][]*ethtypes.Log{}
 	}
 
+	// the aspect executions are only recorded for the txs which ran aspects
+	aspectExecutions, err := utils.TxAspectExecutionsFromEvents(blockRes.TxsResults[res.TxIndex].Events, hash)
+	if err != nil {
+		b.logger.Debug("failed to parse aspect executions from events", "hash", hash.Hex(), "error", err.Error())
+	}
+	if aspectExecutions == nil {
+		aspectExecutions = []support.AspectExecution{}
+	}
+	receipt["aspectExecutions"] = aspectExecutions
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil || aspect.IsAspectDeploy(txData.GetTo(), txData.GetData()) {
 		receipt["contractAddress"] = crypto.CreateAddress(common.HexToAddress(res.Sender), txData.GetNonce())
@@ -422,3 +588,23 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionAr
 	}
 	return hexutil.Uint64(res.Gas), nil
 }
+
+func (b *BackendImpl) CreateAccessList(args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*txs.CreateAccessListResponse, error) {
+	blockNum := rpc.LatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = b.blockNumberFromCosmos(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := b.newCallRequest(args, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := b.callContext(blockNum)
+	defer cancel()
+
+	return b.queryClient.CreateAccessList(ctx, req)
+}
@@ -0,0 +1,214 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// testMempool is the mempool of the node, which returns the txs in the insertion order.
+type testMempool tmtypes.Txs
+
+func (m testMempool) ReapMaxTxs(max int) tmtypes.Txs {
+	if max < 0 || max > len(m) {
+		return tmtypes.Txs(m)
+	}
+	return tmtypes.Txs(m[:max])
+}
+
+func newTestTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64) *ethtypes.Transaction {
+	to := common.BigToAddress(big.NewInt(1))
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(11822)), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestPoolEthTxsBySender(t *testing.T) {
+	encodingConfig := app.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	encode := func(tx *ethtypes.Transaction) tmtypes.Tx {
+		msg := &txs.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(tx))
+		sdkTx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aart")
+		require.NoError(t, err)
+		bz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from1, from2 := crypto.PubkeyToAddress(key1.PublicKey), crypto.PubkeyToAddress(key2.PublicKey)
+
+	// more txs than a page of the unconfirmed_txs query, in reverse nonce order
+	var mempool testMempool
+	for nonce := 2 * maxUnconfirmedTxs; nonce > 0; nonce-- {
+		mempool = append(mempool, encode(newTestTx(t, key1, uint64(nonce))))
+	}
+	mempool = append(mempool, encode(newTestTx(t, key2, 0)))
+
+	// the cosmos txs are skipped
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(
+		sdk.AccAddress(from1.Bytes()), sdk.AccAddress(from2.Bytes()), sdk.NewCoins(sdk.NewInt64Coin("aart", 1)))))
+	cosmosTx, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	mempool = append(mempool, cosmosTx)
+
+	b := &BackendImpl{clientCtx: clientCtx, chainID: big.NewInt(11822), logger: log.Root(), tmMempool: mempool}
+	poolTxs, err := b.poolEthTxsBySender()
+	require.NoError(t, err)
+
+	// the whole mempool is read, and the txs are sorted by nonce
+	require.Len(t, poolTxs, 2)
+	require.Len(t, poolTxs[from1], 2*maxUnconfirmedTxs)
+	for i, tx := range poolTxs[from1] {
+		require.Equal(t, uint64(i+1), tx.Nonce())
+	}
+	require.Len(t, poolTxs[from2], 1)
+}
+
+func TestSplitPendingQueued(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nonces := func(senderTxs ethtypes.Transactions) []uint64 {
+		var res []uint64
+		for _, tx := range senderTxs {
+			res = append(res, tx.Nonce())
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name       string
+		nonce      uint64
+		txNonces   []uint64
+		expPending []uint64
+		expQueued  []uint64
+	}{
+		{"all pending", 1, []uint64{1, 2, 3}, []uint64{1, 2, 3}, nil},
+		{"gap queues the later txs", 1, []uint64{1, 2, 4, 5}, []uint64{1, 2}, []uint64{4, 5}},
+		{"nothing executable", 1, []uint64{3, 4}, nil, []uint64{3, 4}},
+		{"stale txs skipped", 3, []uint64{1, 2, 3, 4}, []uint64{3, 4}, nil},
+		{"duplicated nonce skipped", 1, []uint64{1, 1, 2}, []uint64{1, 2}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var senderTxs ethtypes.Transactions
+			for _, nonce := range tc.txNonces {
+				senderTxs = append(senderTxs, newTestTx(t, key, nonce))
+			}
+
+			pending, queued := splitPendingQueued(tc.nonce, senderTxs)
+			require.Equal(t, tc.expPending, nonces(pending))
+			require.Equal(t, tc.expQueued, nonces(queued))
+		})
+	}
+}
+
+// testCometClient serves the blocks of the chain.
+type testCometClient struct {
+	rpcclient.Client
+	proposer tmbytes.HexBytes
+}
+
+func (c testCometClient) Block(_ context.Context, height *int64) (*tmrpctypes.ResultBlock, error) {
+	return &tmrpctypes.ResultBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height, ProposerAddress: c.proposer}},
+	}, nil
+}
+
+// testEVMQueryClient records the call requests and the heights they are queried at.
+type testEVMQueryClient struct {
+	txs.QueryClient
+	requests []*txs.EthCallRequest
+	heights  []string
+	ret      []byte
+}
+
+func (c *testEVMQueryClient) record(ctx context.Context, req *txs.EthCallRequest) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.requests = append(c.requests, req)
+	c.heights = append(c.heights, strings.Join(md.Get(grpctypes.GRPCBlockHeightHeader), ","))
+}
+
+func (c *testEVMQueryClient) EthCall(ctx context.Context, req *txs.EthCallRequest, _ ...grpc.CallOption) (*txs.MsgEthereumTxResponse, error) {
+	c.record(ctx, req)
+	return &txs.MsgEthereumTxResponse{Ret: c.ret}, nil
+}
+
+func (c *testEVMQueryClient) CreateAccessList(ctx context.Context, req *txs.EthCallRequest, _ ...grpc.CallOption) (*txs.CreateAccessListResponse, error) {
+	c.record(ctx, req)
+	return &txs.CreateAccessListResponse{GasUsed: 21000}, nil
+}
+
+func TestCreateAccessList(t *testing.T) {
+	proposer := tmbytes.HexBytes(common.HexToAddress("0x1").Bytes())
+	queryClient := &testEVMQueryClient{}
+	b := &BackendImpl{
+		ctx:         context.Background(),
+		clientCtx:   client.Context{}.WithClient(testCometClient{proposer: proposer}),
+		queryClient: &rpctypes.QueryClient{QueryClient: queryClient},
+		cfg:         &Config{RPCGasCap: 25000000},
+		chainID:     big.NewInt(11822),
+	}
+
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	args := ethapi.TransactionArgs{From: &from}
+	blockNum := rpc.BlockNumber(5)
+	res, err := b.CreateAccessList(args, &rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), res.GasUsed)
+
+	// the access list is created from the same request as the call
+	_, err = b.DoCall(args, rpc.BlockNumberOrHash{BlockNumber: &blockNum}, nil, nil)
+	require.NoError(t, err)
+
+	require.Len(t, queryClient.requests, 2)
+	require.Equal(t, queryClient.requests[1], queryClient.requests[0])
+	require.Equal(t, []string{"5", "5"}, queryClient.heights)
+
+	req := queryClient.requests[0]
+	require.Equal(t, uint64(25000000), req.GasCap)
+	require.Equal(t, int64(11822), req.ChainId)
+	require.Equal(t, sdk.ConsAddress(proposer), req.ProposerAddress)
+	var reqArgs ethapi.TransactionArgs
+	require.NoError(t, json.Unmarshal(req.Args, &reqArgs))
+	require.Equal(t, from, *reqArgs.From)
+}
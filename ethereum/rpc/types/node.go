@@ -19,4 +19,14 @@ type NetworkingStack interface {
 
 	// Start starts the networking stack.
 	Start() error
+
+	// Close stops the networking stack, the in-flight http requests are drained.
+	Close() error
+
+	// Attach creates an RPC client attached to the in-process API handler.
+	Attach() (*rpc.Client, error)
+
+	// WSHandler returns the RPC request handler of the websocket connections, which only
+	// serves the apis of the websocket modules.
+	WSHandler() (*rpc.Server, error)
 }
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+)
+
+// testStateDB records the overrides applied to the accounts.
+type testStateDB struct {
+	nonces   map[common.Address]uint64
+	codes    map[common.Address][]byte
+	balances map[common.Address]*big.Int
+	storages map[common.Address]map[common.Hash]common.Hash
+}
+
+func newTestStateDB() *testStateDB {
+	return &testStateDB{
+		nonces:   make(map[common.Address]uint64),
+		codes:    make(map[common.Address][]byte),
+		balances: make(map[common.Address]*big.Int),
+		storages: make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (s *testStateDB) SetNonce(addr common.Address, nonce uint64)      { s.nonces[addr] = nonce }
+func (s *testStateDB) SetCode(addr common.Address, code []byte)        { s.codes[addr] = code }
+func (s *testStateDB) SetBalance(addr common.Address, amount *big.Int) { s.balances[addr] = amount }
+
+func (s *testStateDB) SetState(addr common.Address, key, value common.Hash) {
+	if s.storages[addr] == nil {
+		s.storages[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.storages[addr][key] = value
+}
+
+func (s *testStateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	s.storages[addr] = storage
+}
+
+func TestStateOverrideApply(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.HexToHash("0x1")
+	value := common.HexToHash("0x2")
+
+	var overrides StateOverride
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"0x1000000000000000000000000000000000000001": {
+			"nonce": "0x5",
+			"code": "0x6000",
+			"balance": "0x64",
+			"stateDiff": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002"}
+		}
+	}`), &overrides))
+
+	stateDB := newTestStateDB()
+	require.NoError(t, overrides.Apply(stateDB))
+	require.Equal(t, uint64(5), stateDB.nonces[addr])
+	require.Equal(t, []byte{0x60, 0x00}, stateDB.codes[addr])
+	require.Equal(t, big.NewInt(100), stateDB.balances[addr])
+	require.Equal(t, map[common.Hash]common.Hash{slot: value}, stateDB.storages[addr])
+
+	// the whole storage is replaced by the state override
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"0x1000000000000000000000000000000000000001": {
+			"state": {"0x0000000000000000000000000000000000000000000000000000000000000003": "0x0000000000000000000000000000000000000000000000000000000000000004"}
+		}
+	}`), &overrides))
+	require.NoError(t, overrides.Apply(stateDB))
+	require.Equal(t, map[common.Hash]common.Hash{common.HexToHash("0x3"): common.HexToHash("0x4")}, stateDB.storages[addr])
+
+	// state and stateDiff can't be set at the same time
+	storage := map[common.Hash]common.Hash{slot: value}
+	both := StateOverride{addr: OverrideAccount{State: &storage, StateDiff: &storage}}
+	require.Error(t, both.Apply(newTestStateDB()))
+
+	var nilOverrides *StateOverride
+	require.NoError(t, nilOverrides.Apply(newTestStateDB()))
+}
+
+func TestBlockOverrides(t *testing.T) {
+	coinbase := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	var overrides BlockOverrides
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"number": "0x10",
+		"time": "0x20",
+		"gasLimit": "0x30",
+		"coinbase": "0x1000000000000000000000000000000000000001",
+		"baseFee": "0x40"
+	}`), &overrides))
+	require.NoError(t, overrides.Validate())
+
+	blockCtx := vm.BlockContext{BlockNumber: big.NewInt(1), Time: 1, GasLimit: 1, BaseFee: big.NewInt(1)}
+	overrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(0x10), blockCtx.BlockNumber)
+	require.Equal(t, uint64(0x20), blockCtx.Time)
+	require.Equal(t, uint64(0x30), blockCtx.GasLimit)
+	require.Equal(t, coinbase, blockCtx.Coinbase)
+	require.Equal(t, big.NewInt(0x40), blockCtx.BaseFee)
+
+	// the header fields not used by the chain are rejected
+	for _, unsupported := range []string{
+		`{"difficulty": "0x1"}`,
+		`{"random": "0x0000000000000000000000000000000000000000000000000000000000000001"}`,
+	} {
+		var overrides BlockOverrides
+		require.NoError(t, json.Unmarshal([]byte(unsupported), &overrides))
+		require.Error(t, overrides.Validate(), unsupported)
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"math/big"
 	"strings"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 
 	evmtypes "github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
 	feetypes "github.com/artela-network/artela/x/fee/types"
 )
 
@@ -233,6 +235,42 @@ func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	return nil
 }
 
+// BlockBloomFromEvents parses the block bloom from the end block events, an empty bloom is
+// returned if the bloom is not found.
+func BlockBloomFromEvents(events []abci.Event) ethtypes.Bloom {
+	for _, event := range events {
+		if event.Type != evmmodule.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != evmmodule.AttributeKeyEthereumBloom {
+				continue
+			}
+
+			encodedBloom, err := base64.StdEncoding.DecodeString(attr.Value)
+			if err != nil || len(encodedBloom) != ethtypes.BloomByteLength {
+				return ethtypes.Bloom{}
+			}
+			return ethtypes.BytesToBloom(encodedBloom)
+		}
+	}
+	return ethtypes.Bloom{}
+}
+
+// EthHeaderFromNewBlockHeader returns an Ethereum Header from a new block header event,
+// the logs bloom and the base fee are taken from the block events.
+func EthHeaderFromNewBlockHeader(data tmtypes.EventDataNewBlockHeader) *ethtypes.Header {
+	baseFee := BaseFeeFromEvents(data.ResultBeginBlock.Events)
+	bloom := BlockBloomFromEvents(data.ResultEndBlock.Events)
+
+	header := EthHeaderFromTendermint(data.Header, bloom, baseFee)
+	if data.NumTxs == 0 {
+		header.TxHash = ethtypes.EmptyRootHash
+	}
+	return header
+}
+
 // CheckTxFee is an internal function used to check whether the fee of
 // the given txs is _reasonable_(under the cap).
 func CheckTxFee(gasPrice *big.Int, gas uint64, cap float64) error {
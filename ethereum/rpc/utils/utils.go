@@ -11,6 +11,7 @@ import (
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -53,6 +54,35 @@ func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
 	return support.LogsToEthereum(logs), nil
 }
 
+// TxAspectExecutionsFromEvents parses the aspect executions of the eth tx with the given hash from events,
+// nil is returned if no aspect was executed by the tx.
+func TxAspectExecutionsFromEvents(events []abci.Event, txHash common.Hash) ([]support.AspectExecution, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeAspectExecution {
+			continue
+		}
+
+		var executions []support.AspectExecution
+		matched := false
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				matched = attr.Value == txHash.Hex()
+			case evmtypes.AttributeKeyAspectExecution:
+				var execution support.AspectExecution
+				if err := json.Unmarshal([]byte(attr.Value), &execution); err != nil {
+					return nil, err
+				}
+				executions = append(executions, execution)
+			}
+		}
+		if matched {
+			return executions, nil
+		}
+	}
+	return nil, nil
+}
+
 func BlockMaxGasFromConsensusParams(ctx context.Context, clientCtx client.Context, blockHeight int64) (int64, error) {
 	resConsParams, err := clientCtx.Client.ConsensusParams(ctx, &blockHeight)
 	defaultGasLimit := int64(^uint32(0)) // #nosec G701
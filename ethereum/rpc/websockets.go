@@ -3,20 +3,23 @@ package rpc
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
-	"net"
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +28,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	rpcfilter "github.com/artela-network/artela/ethereum/rpc/filters"
@@ -33,11 +37,33 @@ import (
 	"github.com/artela-network/artela/ethereum/server/config"
 	evmtxs "github.com/artela-network/artela/x/evm/txs"
 	evmsupport "github.com/artela-network/artela/x/evm/txs/support"
-	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+const (
+	// syncingPollInterval is the interval to poll the node status for the syncing subscription
+	syncingPollInterval = 3 * time.Second
+	// wsReadLimit is the maximum size of the messages read from the websocket connections
+	wsReadLimit = 15 * 1024 * 1024
+	// drainPollInterval is the interval to check if the in-flight requests are answered on shutdown
+	drainPollInterval = 50 * time.Millisecond
+	// errCodeShuttingDown is the json-rpc error code notified to the subscriptions closed on shutdown
+	errCodeShuttingDown = -32000
+)
+
+var (
+	errTooManyPendingRequests = errors.New("too many pending requests")
+	errConnClosed             = errors.New("connection closed")
+	errShuttingDown           = errors.New("server is shutting down")
+
+	// activeSubscriptionsGauge reports the number of the websocket subscriptions
+	activeSubscriptionsGauge = metrics.NewRegisteredGauge("rpc/subscriptions/active", nil)
 )
 
 type WebsocketsServer interface {
 	Start()
+	// Stop closes the subscriptions and drains the in-flight requests of all connections,
+	// then closes the connections. The connections still busy are closed when ctx is done.
+	Stop(ctx context.Context) error
 }
 
 type SubscriptionResponseJSON struct {
@@ -57,6 +83,18 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
+// SubscriptionErrorNotification notifies the client that a subscription is closed by the server.
+type SubscriptionErrorNotification struct {
+	Jsonrpc string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  *SubscriptionError `json:"params"`
+}
+
+type SubscriptionError struct {
+	Subscription rpc.ID            `json:"subscription"`
+	Error        *ErrorMessageJSON `json:"error"`
+}
+
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
@@ -69,25 +107,41 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	wsAddr     string // listen address of ws server
+	certFile   string
+	keyFile    string
+	api        *pubSubAPI
+	tmWSClient *rpcclient.WSClient
+	stack      types.NetworkingStack
+	guard      *RequestGuard
+	logger     log.Logger
+
+	maxPendingRequests int
+	writeTimeout       time.Duration
+
+	httpSrv  *http.Server
+	mu       sync.Mutex
+	sessions map[*wsSession]struct{}
+	stopped  bool
 }
 
-func NewWebsocketsServer(clientCtx client.Context, tmWSClient *rpcclient.WSClient, cfg *config.Config, logger log.Logger) WebsocketsServer {
+func NewWebsocketsServer(clientCtx client.Context, tmWSClient *rpcclient.WSClient, cfg *config.Config,
+	stack types.NetworkingStack, guard *RequestGuard, backend rpcfilter.Backend, logger log.Logger,
+) WebsocketsServer {
 	logger = logger.New("api", "websocket-server")
-	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		wsAddr:             cfg.JSONRPC.WsAddress,
+		certFile:           cfg.TLS.CertificatePath,
+		keyFile:            cfg.TLS.KeyPath,
+		api:                newPubSubAPI(clientCtx, logger, tmWSClient, backend),
+		tmWSClient:         tmWSClient,
+		stack:              stack,
+		guard:              guard,
+		logger:             logger,
+		maxPendingRequests: cfg.JSONRPC.WsMaxPendingRequests,
+		writeTimeout:       cfg.JSONRPC.WsWriteTimeout,
+		sessions:           make(map[*wsSession]struct{}),
 	}
 }
 
@@ -95,16 +149,20 @@ func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	// #nosec G112 -- the websocket connections outlive the http timeouts
+	s.httpSrv = &http.Server{
+		Addr:    s.wsAddr,
+		Handler: ws,
+	}
+
 	go func() {
 		var err error
 		s.logger.Info("Start HTTP server for WS")
 
 		if s.certFile == "" || s.keyFile == "" {
-			// #nosec G114 -- http functions have no support for timeouts
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = s.httpSrv.ListenAndServe()
 		} else {
-			// #nosec G114 -- http functions have no support for timeouts
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = s.httpSrv.ListenAndServeTLS(s.certFile, s.keyFile)
 		}
 
 		if err != nil {
@@ -117,6 +175,63 @@ func (s *websocketsServer) Start() {
 	}()
 }
 
+// Stop stops accepting connections, then shuts down the open connections concurrently and
+// releases the event system and the cometbft websocket client of the subscriptions.
+func (s *websocketsServer) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopped = true
+	sessions := make([]*wsSession, 0, len(s.sessions))
+	for session := range s.sessions {
+		sessions = append(sessions, session)
+	}
+	s.mu.Unlock()
+
+	var err error
+	if s.httpSrv != nil {
+		// the upgraded websocket connections are not tracked by the http server
+		err = s.httpSrv.Shutdown(ctx)
+	}
+
+	var wg sync.WaitGroup
+	for _, session := range sessions {
+		wg.Add(1)
+		go func(session *wsSession) {
+			defer wg.Done()
+			session.shutdown(ctx, s.logger)
+		}(session)
+	}
+	wg.Wait()
+
+	s.api.events.Stop()
+	if s.tmWSClient != nil && s.tmWSClient.IsRunning() {
+		if stopErr := s.tmWSClient.Stop(); stopErr != nil && err == nil {
+			err = stopErr
+		}
+	}
+
+	s.logger.Info("Stopped HTTP server for WS", "connections", len(sessions))
+	return err
+}
+
+// addSession tracks the session of a new connection, it returns false if the server is stopped.
+func (s *websocketsServer) addSession(session *wsSession) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return false
+	}
+	s.sessions[session] = struct{}{}
+	return true
+}
+
+func (s *websocketsServer) removeSession(session *wsSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, session)
+}
+
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.logger.Info("Start HTTP server for WS")
 
@@ -132,10 +247,12 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	conn.SetReadLimit(wsReadLimit)
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+		mux:          new(sync.Mutex),
+		conn:         conn,
+		writeTimeout: s.writeTimeout,
+	}, s.guard.clientIP(r))
 	s.logger.Info("Success HTTP server for WS ")
 }
 
@@ -153,17 +270,48 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn         *websocket.Conn
+	mux          *sync.Mutex
+	writeTimeout time.Duration
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
 
+	if w.writeTimeout > 0 {
+		if err := w.conn.SetWriteDeadline(time.Now().Add(w.writeTimeout)); err != nil {
+			return err
+		}
+	}
 	return w.conn.WriteJSON(v)
 }
 
+// WriteMessage writes an encoded json message.
+func (w *wsConn) WriteMessage(data []byte) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if w.writeTimeout > 0 {
+		if err := w.conn.SetWriteDeadline(time.Now().Add(w.writeTimeout)); err != nil {
+			return err
+		}
+	}
+	return w.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// WriteClose sends a close control frame, it does not close the connection.
+func (w *wsConn) WriteClose(code int, text string) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	deadline := time.Now().Add(time.Second)
+	if w.writeTimeout > 0 {
+		deadline = time.Now().Add(w.writeTimeout)
+	}
+	return w.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), deadline)
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -177,27 +325,70 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
-	// subscriptions of current connection
-	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
+func (s *websocketsServer) readLoop(wsConn *wsConn, client string) {
+	// the other requests are served by the rpc server of the websocket modules
+	rpcServer, err := s.stack.WSHandler()
+	if err != nil {
+		_ = wsConn.Close() // #nosec G703
+		s.logger.Error("failed to get rpc handler, closing connection", "error", err.Error())
+		return
+	}
+	codec := newWsCodec(wsConn, s.maxPendingRequests, s.guard)
+	session := newWsSession(wsConn, codec, rpcServer)
+	if !s.addSession(session) {
+		_ = wsConn.WriteClose(websocket.CloseGoingAway, errShuttingDown.Error()) // #nosec G703
+		_ = codec.Close()                                                        // #nosec G703
+		return
+	}
+	go rpcServer.ServeCodec(codec.ServerCodec, rpc.OptionMethodInvocation)
+
 	defer func() {
+		s.removeSession(session)
+		_ = codec.Close() // #nosec G703
 		// cancel all subscriptions when connection closed
-		// #nosec G705
-		for _, unsubFn := range subscriptions {
-			unsubFn()
-		}
+		session.unsubscribeAll()
+		session.closeInprocClient()
 	}()
 
 	for {
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
 			_ = wsConn.Close() // #nosec G703
-			s.logger.Error("read message error, breaking read loop", "error", err.Error())
+			if session.isClosing() {
+				s.logger.Debug("connection closed on shutdown, breaking read loop", "error", err.Error())
+			} else {
+				s.logger.Error("read message error, breaking read loop", "error", err.Error())
+			}
 			return
 		}
 
+		if session.isClosing() {
+			s.sendErrResponse(wsConn, errShuttingDown.Error())
+			continue
+		}
+
+		if s.guard != nil {
+			req := s.guard.check(client, mb)
+			if req.serve == nil {
+				if rejections := req.rejections(); rejections != nil {
+					if err := wsConn.WriteMessage(rejections); err != nil {
+						s.logger.Debug("error writing rejected requests", "error", err.Error())
+					}
+				}
+				continue
+			}
+			// the rejected messages of a batch are answered along with the served ones
+			if len(req.errors) > 0 {
+				if err := codec.send(req.serve, req.errors); err != nil {
+					s.sendErrResponse(wsConn, err.Error())
+				}
+				continue
+			}
+			mb = req.serve
+		}
+
 		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			if err := codec.send(mb, nil); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 			}
 			continue
@@ -210,11 +401,12 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 
 		// check if method == eth_subscribe or eth_unsubscribe
-		method, ok := msg["method"].(string)
-		if !ok {
+		method, _ := msg["method"].(string)
+		if !isSubscriptionMethod(method) {
 			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			if err := codec.send(mb, nil); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
+				s.logger.Debug("error sending request", "error", err)
 			}
 
 			continue
@@ -237,70 +429,144 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
-		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+		start := time.Now()
+		success, err := s.serveSubscription(wsConn, session, method, connID, msg)
+		updateServeMetrics(method, success, time.Since(start))
+		if err != nil {
+			_ = wsConn.Close() // #nosec G703
+			s.logger.Error("error writing response, breaking read loop", "method", method, "error", err.Error())
+			return
+		}
+	}
+}
 
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
-			}
-			subscriptions[subID] = unsubFn
+// serveSubscription serves the subscription methods of the websocket connection, it returns
+// true if the request succeeded, and an error if the response failed to be written.
+func (s *websocketsServer) serveSubscription(wsConn *wsConn, session *wsSession, method string, connID float64, msg map[string]interface{}) (bool, error) {
+	switch method {
+	case "eth_subscribe":
+		params, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return false, nil
+		}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  subID,
-			}
+		subID := rpc.NewID()
+		// notifications must not be sent before the subscription response
+		ready := make(chan struct{})
+		unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
+		if err != nil {
+			s.sendErrResponse(wsConn, err.Error())
+			return false, nil
+		}
+		if !session.addSubscription(subID, "eth", unsubFn) {
+			close(ready)
+			s.sendErrResponse(wsConn, errShuttingDown.Error())
+			return false, nil
+		}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				_ = wsConn.Close() // #nosec G703
-				s.logger.Error("error writing subscription response", "error", err)
-				return
-			}
-		case "eth_unsubscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+		res := &SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  subID,
+		}
 
-			id, ok := params[0].(string)
-			if !ok {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
+		err = wsConn.WriteJSON(res)
+		close(ready)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to write subscription response")
+		}
+		return true, nil
+	case "debug_subscribe":
+		params, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return false, nil
+		}
 
-			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+		subID := rpc.NewID()
+		unsubFn, err := s.subscribeDebug(wsConn, session.inprocClient(), subID, params)
+		if err != nil {
+			s.sendErrResponse(wsConn, err.Error())
+			return false, nil
+		}
+		if !session.addSubscription(subID, "debug", unsubFn) {
+			s.sendErrResponse(wsConn, errShuttingDown.Error())
+			return false, nil
+		}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  ok,
-			}
+		res := &SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  subID,
+		}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				_ = wsConn.Close() // #nosec G703
-				s.logger.Error("error writing unsubscribe response", "error", err)
+		if err := wsConn.WriteJSON(res); err != nil {
+			return false, errors.Wrap(err, "failed to write subscription response")
+		}
+		return true, nil
+	case "eth_unsubscribe", "debug_unsubscribe":
+		params, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return false, nil
+		}
+
+		id, ok := params[0].(string)
+		if !ok {
+			s.sendErrResponse(wsConn, "invalid parameters")
+			return false, nil
+		}
+
+		res := &SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  session.unsubscribe(rpc.ID(id)),
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			return false, errors.Wrap(err, "failed to write unsubscribe response")
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// subscribeDebug relays a debug namespace subscription, e.g. traceChain, to the in-process rpc handler
+// and forwards its notifications to the client over websockets.
+func (s *websocketsServer) subscribeDebug(wsConn *wsConn, client *rpc.Client, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+	resultCh := make(chan json.RawMessage)
+	sub, err := client.Subscribe(context.Background(), "debug", resultCh, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			select {
+			case result := <-resultCh:
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "debug_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					s.logger.Debug("error writing debug subscription result", "error", err.Error())
+					sub.Unsubscribe()
+					return
+				}
+			case err, ok := <-sub.Err():
+				if ok && err != nil {
+					s.logger.Debug("dropping debug WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				}
 				return
 			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				s.logger.Error("error sending response", "error", err)
-			}
 		}
-	}
+	}()
+
+	return sub.Unsubscribe, nil
 }
 
 // tcpGetAndSendResponse sends error response to client if params is invalid
@@ -319,55 +585,482 @@ func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, ws
 	return params, true
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
-	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
-	if err != nil {
-		return errors.Wrap(err, "Could not build request")
+// wsSession holds the subscriptions and the rpc codec of a websocket connection.
+type wsSession struct {
+	conn  *wsConn
+	codec *wsCodec
+	srv   *rpc.Server
+
+	mu            sync.Mutex
+	subscriptions map[rpc.ID]wsSubscription
+	closing       bool
+
+	// in-process client for the subscriptions served by the rpc apis, created on demand
+	// and only used by the read loop of the connection
+	client *rpc.Client
+}
+
+type wsSubscription struct {
+	namespace   string
+	unsubscribe pubsub.UnsubscribeFunc
+}
+
+func newWsSession(conn *wsConn, codec *wsCodec, srv *rpc.Server) *wsSession {
+	return &wsSession{
+		conn:          conn,
+		codec:         codec,
+		srv:           srv,
+		subscriptions: make(map[rpc.ID]wsSubscription),
 	}
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+func (ws *wsSession) isClosing() bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	return ws.closing
+}
+
+// addSubscription tracks a subscription of the connection, the subscription is cancelled
+// and false returned if the session is shutting down.
+func (ws *wsSession) addSubscription(id rpc.ID, namespace string, unsubscribe pubsub.UnsubscribeFunc) bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if ws.closing {
+		unsubscribe()
+		return false
+	}
+	ws.subscriptions[id] = wsSubscription{namespace: namespace, unsubscribe: unsubscribe}
+	activeSubscriptionsGauge.Inc(1)
+	return true
+}
+
+// unsubscribe cancels a subscription, it returns false if the subscription is not found.
+func (ws *wsSession) unsubscribe(id rpc.ID) bool {
+	ws.mu.Lock()
+	sub, ok := ws.subscriptions[id]
+	delete(ws.subscriptions, id)
+	ws.mu.Unlock()
+
+	if ok {
+		activeSubscriptionsGauge.Dec(1)
+		sub.unsubscribe()
+	}
+	return ok
+}
+
+// takeSubscriptions removes and returns all the subscriptions of the connection.
+func (ws *wsSession) takeSubscriptions() map[rpc.ID]wsSubscription {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	subscriptions := ws.subscriptions
+	ws.subscriptions = make(map[rpc.ID]wsSubscription)
+	activeSubscriptionsGauge.Dec(int64(len(subscriptions)))
+	return subscriptions
+}
+
+// inprocClient returns the in-process client of the connection, dialing the rpc server of
+// the connection on first use.
+func (ws *wsSession) inprocClient() *rpc.Client {
+	if ws.client == nil {
+		ws.client = rpc.DialInProc(ws.srv)
+	}
+	return ws.client
+}
+
+func (ws *wsSession) closeInprocClient() {
+	if ws.client != nil {
+		ws.client.Close()
+	}
+}
+
+func (ws *wsSession) unsubscribeAll() {
+	for _, sub := range ws.takeSubscriptions() {
+		sub.unsubscribe()
+	}
+}
+
+// shutdown rejects the new requests of the connection, notifies the client of the closed
+// subscriptions and waits for the in-flight requests to be answered, then closes the
+// connection with a going away close frame.
+func (ws *wsSession) shutdown(ctx context.Context, logger log.Logger) {
+	ws.mu.Lock()
+	ws.closing = true
+	ws.mu.Unlock()
+
+	for id, sub := range ws.takeSubscriptions() {
+		sub.unsubscribe()
+
+		res := &SubscriptionErrorNotification{
+			Jsonrpc: "2.0",
+			Method:  sub.namespace + "_subscription",
+			Params: &SubscriptionError{
+				Subscription: id,
+				Error: &ErrorMessageJSON{
+					Code:    big.NewInt(errCodeShuttingDown),
+					Message: errShuttingDown.Error(),
+				},
+			},
+		}
+		if err := ws.conn.WriteJSON(res); err != nil {
+			logger.Debug("error notifying closed subscription", "subscription-id", id, "error", err.Error())
+		}
 	}
 
-	defer resp.Body.Close()
+	if err := ws.codec.drain(ctx); err != nil {
+		logger.Debug("dropping in-flight websocket requests", "pending", ws.codec.pendingRequests(), "error", err.Error())
+	}
+
+	if err := ws.conn.WriteClose(websocket.CloseGoingAway, errShuttingDown.Error()); err != nil {
+		logger.Debug("error writing websocket close frame", "error", err.Error())
+	}
+	_ = ws.codec.Close() // #nosec G703
+}
+
+// wsCodec feeds the json-rpc requests read from a websocket connection to the in-process
+// rpc server, and writes the responses of the rpc server back to the connection.
+type wsCodec struct {
+	rpc.ServerCodec
+
+	conn       *wsConn
+	msgs       chan []byte
+	pending    atomic.Int64 // number of the requests in flight
+	maxPending int64        // maximum number of the requests in flight, 0 if unlimited
+	guard      *RequestGuard
+	closeCh    chan struct{}
+	closeOnce  sync.Once
+
+	// rejected holds the responses of the messages rejected from the batches in flight,
+	// keyed by the token tagging the batch, see tagBatch
+	mu         sync.Mutex
+	batchToken string // random prefix of the tokens, so that the clients cannot guess them
+	batchSeq   uint64
+	rejected   map[string]rejectedBatch
+}
+
+// rejectedBatch holds the responses of the messages rejected from a batch in flight, and
+// the original id of the message tagged with the token of the batch.
+type rejectedBatch struct {
+	id        json.RawMessage
+	responses []json.RawMessage
+}
+
+func newBatchToken() string {
+	token := make([]byte, 8)
+	_, _ = rand.Read(token) // #nosec G104
+	return "batch-" + hex.EncodeToString(token)
+}
+
+func newWsCodec(conn *wsConn, maxPendingRequests int, guard *RequestGuard) *wsCodec {
+	codec := &wsCodec{
+		conn:       conn,
+		msgs:       make(chan []byte),
+		maxPending: int64(maxPendingRequests),
+		guard:      guard,
+		closeCh:    make(chan struct{}),
+		batchToken: newBatchToken(),
+		rejected:   make(map[string]rejectedBatch),
+	}
+	codec.ServerCodec = rpc.NewFuncCodec(codec, codec.encode, codec.decode)
+	return codec
+}
 
-	body, err := io.ReadAll(resp.Body)
+// send queues a json-rpc message or batch to the rpc server, it fails if the connection
+// has too many requests in flight. The responses of the messages rejected from a batch
+// are merged into the response of the batch, like the http handler of the guard does.
+func (c *wsCodec) send(mb []byte, rejected []json.RawMessage) error {
+	expectsResponse, err := expectsResponse(mb)
 	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
+		return err
+	}
+
+	if expectsResponse {
+		if pending := c.pending.Add(1); c.maxPending > 0 && pending > c.maxPending {
+			c.pending.Add(-1)
+			return errTooManyPendingRequests
+		}
+		if len(rejected) > 0 {
+			tagged, ok := c.tagBatch(mb, rejected)
+			if ok {
+				mb, rejected = tagged, nil
+			}
+		}
+	}
+
+	select {
+	case c.msgs <- mb:
+		if len(rejected) > 0 {
+			// the rpc server does not answer a batch of notifications, and a batch without
+			// a message to tag is answered apart
+			return c.conn.WriteMessage(mergeResponses(nil, rejected))
+		}
+		return nil
+	case <-c.closeCh:
+		if expectsResponse {
+			c.pending.Add(-1)
+		}
+		return errConnClosed
+	}
+}
+
+func (c *wsCodec) pendingRequests() int64 {
+	return c.pending.Load()
+}
+
+// drain waits until the requests in flight are answered, or the codec is closed.
+func (c *wsCodec) drain(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for c.pendingRequests() > 0 {
+		select {
+		case <-ticker.C:
+		case <-c.closeCh:
+			return errConnClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
+	return nil
+}
+
+func (c *wsCodec) decode(v interface{}) error {
+	select {
+	case mb := <-c.msgs:
+		return json.Unmarshal(mb, v)
+	case <-c.closeCh:
+		return io.EOF
+	}
+}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
+func (c *wsCodec) encode(v interface{}, _ bool) error {
+	// every message written by the rpc server answers a request, as subscriptions are not served by it
+	defer c.pending.Add(-1)
+	if c.guard == nil || (c.guard.maxResponseSize == 0 && !c.hasRejected()) {
+		return c.conn.WriteJSON(v)
+	}
+
+	data, err := json.Marshal(v)
 	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+		return err
+	}
+	if isBatch(data) && c.hasRejected() {
+		data = c.untagBatch(data)
 	}
+	return c.conn.WriteMessage(c.guard.limitResponse(data))
+}
+
+func (c *wsCodec) hasRejected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.rejected) > 0
+}
 
-	return wsConn.WriteJSON(wsSend)
+// tagBatch replaces the id of the first message of a batch answered by the rpc server with
+// a token unique to the codec, which pairs the batch response with the rejected responses
+// to merge, whatever the ids chosen by the client. It returns false if the batch has no
+// message to tag.
+func (c *wsCodec) tagBatch(mb []byte, rejected []json.RawMessage) ([]byte, bool) {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(mb, &batch); err != nil {
+		return nil, false
+	}
+	for i, raw := range batch {
+		var msg rpcMessage
+		if err := json.Unmarshal(raw, &msg); err != nil || !msg.expectsResponse() || !isTaggableID(msg.ID) {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			continue
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.batchSeq++
+		token := strconv.Quote(fmt.Sprintf("%s-%d", c.batchToken, c.batchSeq))
+		fields["id"] = json.RawMessage(token)
+		tagged, err := json.Marshal(fields)
+		if err != nil {
+			return nil, false
+		}
+		batch[i] = tagged
+		if mb, err = json.Marshal(batch); err != nil {
+			return nil, false
+		}
+		c.rejected[token] = rejectedBatch{id: msg.ID, responses: rejected}
+		return mb, true
+	}
+	return nil, false
+}
+
+// untagBatch restores the id of the response tagged by tagBatch, and merges the rejected
+// responses of the batch into the batch response.
+func (c *wsCodec) untagBatch(data []byte) []byte {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return data
+	}
+	for i, raw := range batch {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil || len(fields["id"]) == 0 {
+			continue
+		}
+
+		token := idKey(fields["id"])
+		c.mu.Lock()
+		rejected, ok := c.rejected[token]
+		delete(c.rejected, token)
+		c.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		fields["id"] = rejected.id
+		restored, err := json.Marshal(fields)
+		if err != nil {
+			return data
+		}
+		batch[i] = restored
+		merged, _ := json.Marshal(batch) // the responses are valid json
+		return mergeResponses(merged, rejected.responses)
+	}
+	return data
+}
+
+// SetWriteDeadline is a no-op, the write deadline is set by the websocket connection on every write.
+func (c *wsCodec) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// RemoteAddr returns the address of the websocket client.
+func (c *wsCodec) RemoteAddr() string {
+	return c.conn.conn.RemoteAddr().String()
+}
+
+// Close stops feeding the rpc server and closes the websocket connection, it is also
+// called by the rpc server when it stops.
+func (c *wsCodec) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closeCh)
+		_ = c.conn.Close() // #nosec G703
+	})
+	return nil
+}
+
+// rpcMessage holds the fields of a json-rpc message which decide if it is answered by the rpc server.
+type rpcMessage struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	Error   json.RawMessage `json:"error"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// expectsResponse follows the rules of the rpc server, only the notifications and the
+// responses are not answered.
+func (msg *rpcMessage) expectsResponse() bool {
+	if msg.Version != "2.0" {
+		return true
+	}
+	isNotification := msg.ID == nil && msg.Method != ""
+	hasValidID := len(msg.ID) > 0 && msg.ID[0] != '{' && msg.ID[0] != '['
+	isResponse := hasValidID && msg.Method == "" && msg.Params == nil && (msg.Result != nil || msg.Error != nil)
+	return !isNotification && !isResponse
+}
+
+// expectsResponse returns true if the rpc server answers the json-rpc message or batch.
+func expectsResponse(mb []byte) (bool, error) {
+	if !isBatch(mb) {
+		var msg rpcMessage
+		if err := json.Unmarshal(mb, &msg); err != nil {
+			return false, err
+		}
+		return msg.expectsResponse(), nil
+	}
+
+	var batch []*rpcMessage
+	if err := json.Unmarshal(mb, &batch); err != nil {
+		return false, err
+	}
+	// empty batches are answered with an error
+	if len(batch) == 0 {
+		return true, nil
+	}
+	for _, msg := range batch {
+		if msg == nil || msg.expectsResponse() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isTaggableID returns true if the id is a string or a number, the invalid ids are answered
+// with an error by the rpc server, and the null ones with a null id.
+func isTaggableID(id json.RawMessage) bool {
+	return len(id) > 0 && (id[0] == '"' || id[0] == '-' || (id[0] >= '0' && id[0] <= '9'))
+}
+
+func idKey(id json.RawMessage) string {
+	var buf bytes.Buffer
+	if len(id) == 0 || json.Compact(&buf, id) != nil {
+		return "null"
+	}
+	return buf.String()
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *rpcfilter.EventSystem
+	backend   rpcfilter.Backend
 	logger    log.Logger
 	clientCtx client.Context
+
+	// syncingInterval is the interval to poll the node status for the syncing subscriptions
+	syncingInterval time.Duration
+
+	// the block gas limit of the last new header, shared by the newHeads subscriptions
+	gasLimitMux    sync.Mutex
+	gasLimitHeight int64
+	gasLimit       int64
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, backend rpcfilter.Backend) *pubSubAPI {
 	logger = logger.New("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilter.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:          rpcfilter.NewEventSystem(logger, tmWSClient),
+		backend:         backend,
+		logger:          logger,
+		clientCtx:       clientCtx,
+		syncingInterval: syncingPollInterval,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// blockGasLimit returns the block gas limit at the given height, the consensus params are
+// queried once per height no matter how many newHeads subscriptions are notified.
+func (api *pubSubAPI) blockGasLimit(height int64) int64 {
+	api.gasLimitMux.Lock()
+	defer api.gasLimitMux.Unlock()
+
+	if api.gasLimitHeight == height {
+		return api.gasLimit
+	}
+
+	gasLimit, err := types.BlockMaxGasFromConsensusParams(context.Background(), api.clientCtx, height)
+	if err != nil {
+		api.logger.Debug("failed to query consensus params", "height", height, "error", err.Error())
+		return gasLimit
+	}
+
+	api.gasLimitHeight, api.gasLimit = height, gasLimit
+	return gasLimit
+}
+
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -375,15 +1068,22 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 
 	switch method {
 	case "newHeads":
-		// TODO: handle extra params
+		// newHeads takes no extra params, same as geth
 		return api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		// the optional fullTx flag returns the full transactions instead of the hashes
+		fullTx := false
+		if len(params) > 1 && params[1] != nil {
+			if fullTx, ok = params[1].(bool); !ok {
+				return nil, errors.New("invalid parameters; fullTx must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -413,59 +1113,32 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
-				baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
-
 				cosmosHash := data.Header.Hash()
+				header := types.EthHeaderFromNewBlockHeader(data)
 
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
-				if data.NumTxs == 0 {
-					header.TxHash = ethtypes.EmptyTxsHash
-				}
-
-				bloom := ethtypes.Bloom{}
-				for _, et := range data.ResultEndBlock.Events {
-					if et.Type != evmtypes.EventTypeBlockBloom {
-						continue
-					}
+				gasLimit := api.blockGasLimit(data.Header.Height)
 
-					for _, attr := range et.Attributes {
-						if attr.Key == evmtypes.AttributeKeyEthereumBloom {
-							encodedBloom, deCodeErr := base64.StdEncoding.DecodeString(attr.Value)
-
-							sprintf := fmt.Sprintf("subscribeNewHeads event %d bloom %s header %d, ", len(bloom.Bytes()), attr.Value, data.Header.Height)
-							api.logger.Info(sprintf)
-
-							if len(encodedBloom) != 256 {
-								continue
-							}
-							if deCodeErr == nil {
-								bloom = ethtypes.BytesToBloom(encodedBloom)
-							}
-						}
-					}
-				}
-
-				// TODO: Eth TransactionsRoot unable to obtain ，now remove transactionsRoot，
 				result := map[string]interface{}{
-					"parentHash":      header.ParentHash,
-					"sha3Uncles":      header.UncleHash,
-					"miner":           header.Coinbase,
-					"stateRoot":       header.Root,
-					"receiptsRoot":    header.ReceiptHash,
-					"logsBloom":       bloom,
-					"difficulty":      header.Difficulty,
-					"number":          header.Number,
-					"gasLimit":        header.GasLimit,
-					"gasUsed":         header.GasUsed,
-					"timestamp":       header.Time,
-					"extraData":       header.Extra,
-					"mixHash":         header.MixDigest,
-					"nonce":           header.Nonce,
-					"baseFeePerGas":   header.BaseFee,
-					"withdrawalsRoot": header.WithdrawalsHash,
-					"excessDataGas":   header.ExcessDataGas,
-					"hash":            hexutil.Encode(cosmosHash.Bytes()),
-					"size":            0,
+					"parentHash":       header.ParentHash,
+					"sha3Uncles":       header.UncleHash,
+					"miner":            header.Coinbase,
+					"stateRoot":        header.Root,
+					"transactionsRoot": header.TxHash,
+					"receiptsRoot":     header.ReceiptHash,
+					"logsBloom":        header.Bloom,
+					"difficulty":       (*hexutil.Big)(header.Difficulty),
+					"number":           (*hexutil.Big)(header.Number),
+					"gasLimit":         hexutil.Uint64(gasLimit), // #nosec G701
+					"gasUsed":          hexutil.Uint64(header.GasUsed),
+					"timestamp":        hexutil.Uint64(header.Time),
+					"extraData":        hexutil.Bytes(header.Extra),
+					"mixHash":          header.MixDigest,
+					"nonce":            header.Nonce,
+					"baseFeePerGas":    (*hexutil.Big)(header.BaseFee),
+					"withdrawalsRoot":  header.WithdrawalsHash,
+					"excessDataGas":    header.ExcessDataGas,
+					"hash":             hexutil.Encode(cosmosHash.Bytes()),
+					"size":             hexutil.Uint64(0),
 				}
 
 				// write to ws conn
@@ -478,7 +1151,7 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					},
 				}
 
-				err = wsConn.WriteJSON(res)
+				err := wsConn.WriteJSON(res)
 				if err != nil {
 					api.logger.Error("error writing header, will drop peer", "error", err.Error())
 
@@ -517,7 +1190,9 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribeLogs streams the logs matching the criteria, if fromBlock is specified the historical logs
+// since that block are replayed first, then it switches to the live logs without gaps or duplicates.
+func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -554,6 +1229,24 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			}
 		}
 
+		if params["fromBlock"] != nil {
+			fromBlock, err := parseBlockNumberParam(params["fromBlock"])
+			if err != nil {
+				api.logger.Debug("invalid fromBlock", "error", err.Error())
+				return nil, errors.Wrap(err, "invalid fromBlock")
+			}
+			crit.FromBlock = big.NewInt(fromBlock.Int64())
+		}
+
+		if params["toBlock"] != nil {
+			toBlock, err := parseBlockNumberParam(params["toBlock"])
+			if err != nil {
+				api.logger.Debug("invalid toBlock", "error", err.Error())
+				return nil, errors.Wrap(err, "invalid toBlock")
+			}
+			crit.ToBlock = big.NewInt(toBlock.Int64())
+		}
+
 		if params["topics"] != nil {
 			topics, ok := params["topics"].([]interface{})
 			if !ok {
@@ -621,9 +1314,82 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		return nil, err
 	}
 
+	// the historical logs up to the head are replayed if fromBlock is specified
+	var replayHead int64
+	if rpcfilter.ShouldReplayLogs(crit) {
+		if replayHead, err = rpcfilter.ReplayHead(context.Background(), api.backend); err != nil {
+			unsubFn()
+			return nil, err
+		}
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			close(done)
+			unsubFn()
+		})
+	}
+
+	writeLogs := func(logs []*ethtypes.Log) error {
+		for _, ethLog := range logs {
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       ethLog,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				try(func() {
+					if !errors.Is(websocket.ErrCloseSent, err) {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	}
+
 	go func() {
 		ch := sub.Event()
 		errCh := sub.Err()
+
+		select {
+		case <-ready:
+		case <-done:
+			return
+		}
+
+		// replay the historical logs first if fromBlock is specified, meanwhile the live events
+		// are buffered, and the ones of the replayed blocks are skipped afterwards.
+		var bufferErrCh <-chan error
+		if rpcfilter.ShouldReplayLogs(crit) {
+			ch, bufferErrCh = rpcfilter.BufferEvents(ch, done)
+
+			// stop replaying once the client unsubscribes or disconnects
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-done:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+
+			err := rpcfilter.ReplayLogs(ctx, api.logger, api.backend, crit, replayHead, writeLogs)
+			cancel()
+			if err != nil {
+				api.logger.Debug("failed to replay logs", "subscription-id", subID, "error", err.Error())
+				unsubscribe()
+				return
+			}
+		}
+
 		for {
 			select {
 			case event, ok := <-ch:
@@ -637,6 +1403,11 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					continue
 				}
 
+				// the logs of this block have been replayed already
+				if dataTx.TxResult.Height <= replayHead {
+					continue
+				}
+
 				txResponse, err := evmtxs.DecodeTxResponse(dataTx.TxResult.Result.Data)
 				if err != nil {
 					api.logger.Error("failed to decode tx response", "error", err.Error())
@@ -648,38 +1419,41 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					continue
 				}
 
-				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
-					}
-
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if !errors.Is(websocket.ErrCloseSent, err) {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
-					}
-				}
+				_ = writeLogs(logs)
+			case err := <-bufferErrCh:
+				// too many live events buffered while replaying
+				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				unsubscribe()
+				return
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
 				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case <-done:
+				return
 			}
 		}
 	}()
 
-	return unsubFn, nil
+	return unsubscribe, nil
+}
+
+// parseBlockNumberParam parses a block number param, either a hex number or a block tag.
+func parseBlockNumberParam(param interface{}) (rpc.BlockNumber, error) {
+	bz, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+
+	var blockNum rpc.BlockNumber
+	if err := blockNum.UnmarshalJSON(bz); err != nil {
+		return 0, err
+	}
+	return blockNum, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -704,13 +1478,22 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					var result interface{} = ethTx.Hash
+					if fullTx {
+						tx := ethTx.AsTransaction()
+						if result, err = types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, tx.ChainId()); err != nil {
+							api.logger.Debug("failed to build rpc transaction", "hash", ethTx.Hash, "error", err.Error())
+							continue
+						}
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -737,11 +1520,126 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing polls the CometBFT node status and notifies the subscriber whenever the syncing state changes,
+// the sync progress is sent when the node starts catching up and false is sent when it is done, same as geth.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying node status")
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	unsubFn := func() {
+		once.Do(func() { close(done) })
+	}
+
+	notify := func(result interface{}) bool {
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if !errors.Is(websocket.ErrCloseSent, err) {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+			return false
+		}
+		return true
+	}
+
+	syncingResult := func(startingBlock int64, status *coretypes.ResultStatus) interface{} {
+		if !status.SyncInfo.CatchingUp {
+			return false
+		}
+		return map[string]interface{}{
+			"syncing": true,
+			"status": map[string]interface{}{
+				"startingBlock": hexutil.Uint64(startingBlock),                     // #nosec G701
+				"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight), // #nosec G701
+			},
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(api.syncingInterval)
+		defer ticker.Stop()
+
+		syncing := status.SyncInfo.CatchingUp
+		startingBlock := status.SyncInfo.LatestBlockHeight
+		if syncing && !notify(syncingResult(startingBlock, status)) {
+			return
+		}
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				status, err := api.clientCtx.Client.Status(context.Background())
+				if err != nil {
+					api.logger.Debug("failed to query node status", "error", err.Error())
+					continue
+				}
+
+				if status.SyncInfo.CatchingUp == syncing {
+					continue
+				}
+				syncing = status.SyncInfo.CatchingUp
+				if syncing {
+					startingBlock = status.SyncInfo.LatestBlockHeight
+				}
+
+				if !notify(syncingResult(startingBlock, status)) {
+					return
+				}
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// updateServeMetrics records a request served by the websocket server itself to the rpc
+// metrics of the go-ethereum rpc server, see updateServeTimeHistogram of go-ethereum/rpc.
+func updateServeMetrics(method string, success bool, elapsed time.Duration) {
+	metrics.GetOrRegisterGauge("rpc/requests", nil).Inc(1)
+	note := "success"
+	if success {
+		metrics.GetOrRegisterGauge("rpc/success", nil).Inc(1)
+	} else {
+		metrics.GetOrRegisterGauge("rpc/failure", nil).Inc(1)
+		note = "failure"
+	}
+	metrics.GetOrRegisterTimer("rpc/duration/all", nil).Update(elapsed)
+
+	sampler := func() metrics.Sample {
+		return metrics.ResettingSample(metrics.NewExpDecaySample(1028, 0.015))
+	}
+	h := fmt.Sprintf("rpc/duration/%s/%s", method, note)
+	metrics.GetOrRegisterHistogramLazy(h, nil, sampler).Update(elapsed.Microseconds())
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
+// isSubscriptionMethod returns true if the method is served by the subscriptions of the websocket server
+func isSubscriptionMethod(method string) bool {
+	switch method {
+	case "eth_subscribe", "eth_unsubscribe", "debug_subscribe", "debug_unsubscribe":
+		return true
+	default:
+		return false
+	}
+}
+
 // isBatch returns true when the first non-whitespace characters is '['
 func isBatch(raw []byte) bool {
 	for _, c := range raw {
@@ -0,0 +1,463 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	tmquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmwsclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	rpcfilter "github.com/artela-network/artela/ethereum/rpc/filters"
+	"github.com/artela-network/artela/ethereum/server/config"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// the queries of the cometbft events subscribed by the event system
+var (
+	testHeaderEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
+	testTxEvents     = tmtypes.QueryForEvent(tmtypes.EventTx).String()
+	testEVMEvents    = tmquery.MustParse(fmt.Sprintf("%s='%s' AND %s.%s='%s'",
+		tmtypes.EventTypeKey, tmtypes.EventTx, sdk.EventTypeMessage, sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
+)
+
+// testNodeClient serves the consensus params and the sync status of the node.
+type testNodeClient struct {
+	rpcclient.Client
+
+	mu          sync.Mutex
+	paramsCalls []int64
+	catchingUp  []bool // the sync states returned in turn, the last one is repeated
+}
+
+func (c *testNodeClient) ConsensusParams(_ context.Context, height *int64) (*tmrpctypes.ResultConsensusParams, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paramsCalls = append(c.paramsCalls, *height)
+
+	params := tmtypes.DefaultConsensusParams()
+	params.Block.MaxGas = 1000000
+	return &tmrpctypes.ResultConsensusParams{BlockHeight: *height, ConsensusParams: *params}, nil
+}
+
+func (c *testNodeClient) Status(context.Context) (*tmrpctypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	catchingUp := c.catchingUp[0]
+	if len(c.catchingUp) > 1 {
+		c.catchingUp = c.catchingUp[1:]
+	}
+	return &tmrpctypes.ResultStatus{SyncInfo: tmrpctypes.SyncInfo{LatestBlockHeight: 10, CatchingUp: catchingUp}}, nil
+}
+
+// newTestCometWSClient returns a started cometbft websocket client connected to a node which
+// ignores the requests, the events are sent to the client through its responses channel.
+func newTestCometWSClient(t *testing.T) *tmwsclient.WSClient {
+	upgrader := websocket.Upgrader{}
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(node.Close)
+
+	wsClient, err := tmwsclient.NewWS(node.URL, "/websocket")
+	require.NoError(t, err)
+	require.NoError(t, wsClient.Start())
+	t.Cleanup(func() { _ = wsClient.Stop() })
+	return wsClient
+}
+
+// sendEvent delivers an event of the given query to the cometbft websocket client.
+func sendEvent(t *testing.T, wsClient *tmwsclient.WSClient, query string, data tmtypes.TMEventData) {
+	// the event bus drops the events of the subscribers which are not waiting for one,
+	// give the subscription loops the time to start
+	time.Sleep(100 * time.Millisecond)
+
+	result, err := tmjson.Marshal(tmrpctypes.ResultEvent{Query: query, Data: data})
+	require.NoError(t, err)
+	wsClient.ResponsesCh <- jsonrpctypes.RPCResponse{JSONRPC: "2.0", Result: result}
+}
+
+// newTestWSConn returns the server side of a websocket connection, and the connection of its client.
+func newTestWSConn(t *testing.T) (*wsConn, *websocket.Conn) {
+	upgrader := websocket.Upgrader{}
+	conns := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		conns <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return &wsConn{conn: <-conns, mux: new(sync.Mutex)}, conn
+}
+
+// readNotification reads the next subscription notification sent to the client.
+func readNotification(t *testing.T, conn *websocket.Conn) (rpc.ID, json.RawMessage) {
+	var msg struct {
+		Method string `json:"method"`
+		Params struct {
+			Subscription rpc.ID          `json:"subscription"`
+			Result       json.RawMessage `json:"result"`
+		} `json:"params"`
+	}
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, conn.ReadJSON(&msg))
+	require.Equal(t, "eth_subscription", msg.Method)
+	return msg.Params.Subscription, msg.Params.Result
+}
+
+func TestSubscribeNewHeads(t *testing.T) {
+	wsClient := newTestCometWSClient(t)
+	node := &testNodeClient{}
+	api := newPubSubAPI(client.Context{}.WithClient(node), log.Root(), wsClient, nil)
+	defer api.events.Stop()
+
+	// two subscriptions on the same connection
+	conn, clientConn := newTestWSConn(t)
+	unsub1, err := api.subscribe(conn, "0x1", []interface{}{"newHeads"}, nil)
+	require.NoError(t, err)
+	defer unsub1()
+	unsub2, err := api.subscribe(conn, "0x2", []interface{}{"newHeads"}, nil)
+	require.NoError(t, err)
+	defer unsub2()
+
+	bloom := ethtypes.BytesToBloom(common.LeftPadBytes([]byte{0x1}, ethtypes.BloomByteLength))
+	header := tmtypes.Header{Height: 5, Time: time.Unix(100, 0)}
+	sendEvent(t, wsClient, testHeaderEvents, tmtypes.EventDataNewBlockHeader{
+		Header: header,
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{{
+			Type: evmtypes.EventTypeBlockBloom,
+			Attributes: []abci.EventAttribute{{
+				Key:   evmtypes.AttributeKeyEthereumBloom,
+				Value: base64.StdEncoding.EncodeToString(bloom.Bytes()),
+			}},
+		}}},
+	})
+
+	subs := make(map[rpc.ID]bool)
+	for i := 0; i < 2; i++ {
+		id, raw := readNotification(t, clientConn)
+		subs[id] = true
+
+		var res map[string]interface{}
+		require.NoError(t, json.Unmarshal(raw, &res))
+		require.Equal(t, "0x5", res["number"])
+		require.Equal(t, "0x64", res["timestamp"])
+		require.Equal(t, hexutil.Uint64(1000000).String(), res["gasLimit"])
+		require.Equal(t, hexutil.Encode(bloom.Bytes()), res["logsBloom"])
+		require.Equal(t, ethtypes.EmptyRootHash.Hex(), res["transactionsRoot"])
+		require.Equal(t, hexutil.Encode(header.Hash()), res["hash"])
+	}
+	require.Equal(t, map[rpc.ID]bool{"0x1": true, "0x2": true}, subs)
+
+	// the consensus params are queried once per height
+	sendEvent(t, wsClient, testHeaderEvents, tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: 6}, NumTxs: 1})
+	for i := 0; i < 2; i++ {
+		_, raw := readNotification(t, clientConn)
+		var res map[string]interface{}
+		require.NoError(t, json.Unmarshal(raw, &res))
+		require.Equal(t, "0x6", res["number"])
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	require.Equal(t, []int64{5, 6}, node.paramsCalls)
+}
+
+func TestSubscribePendingTransactions(t *testing.T) {
+	wsClient := newTestCometWSClient(t)
+	encodingConfig := app.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+	api := newPubSubAPI(clientCtx, log.Root(), wsClient, nil)
+	defer api.events.Stop()
+
+	hashConn, hashClient := newTestWSConn(t)
+	unsub, err := api.subscribe(hashConn, "0x1", []interface{}{"newPendingTransactions"}, nil)
+	require.NoError(t, err)
+	defer unsub()
+	fullConn, fullClient := newTestWSConn(t)
+	unsub, err = api.subscribe(fullConn, "0x2", []interface{}{"newPendingTransactions", true}, nil)
+	require.NoError(t, err)
+	defer unsub()
+
+	_, err = api.subscribe(fullConn, "0x3", []interface{}{"newPendingTransactions", "true"}, nil)
+	require.EqualError(t, err, "invalid parameters; fullTx must be a boolean")
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx := newTestTx(t, key, 3)
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	sdkTx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	bz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+	require.NoError(t, err)
+	sendEvent(t, wsClient, testTxEvents, tmtypes.EventDataTx{TxResult: abci.TxResult{Height: 1, Tx: bz}})
+
+	id, raw := readNotification(t, hashClient)
+	require.Equal(t, rpc.ID("0x1"), id)
+	var hash common.Hash
+	require.NoError(t, json.Unmarshal(raw, &hash))
+	require.Equal(t, tx.Hash(), hash)
+
+	id, raw = readNotification(t, fullClient)
+	require.Equal(t, rpc.ID("0x2"), id)
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.Equal(t, tx.Hash().Hex(), res["hash"])
+	require.Equal(t, "0x3", res["nonce"])
+	require.Equal(t, strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()), strings.ToLower(res["from"].(string)))
+	require.Nil(t, res["blockHash"])
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	// the node catches up, stays syncing for a poll and then finishes syncing
+	node := &testNodeClient{catchingUp: []bool{true, true, false}}
+	api := &pubSubAPI{clientCtx: client.Context{}.WithClient(node), logger: log.Root(), syncingInterval: 10 * time.Millisecond}
+
+	conn, clientConn := newTestWSConn(t)
+	unsub, err := api.subscribe(conn, "0x1", []interface{}{"syncing"}, nil)
+	require.NoError(t, err)
+
+	_, raw := readNotification(t, clientConn)
+	require.JSONEq(t, `{"syncing": true, "status": {"startingBlock": "0xa", "currentBlock": "0xa"}}`, string(raw))
+	_, raw = readNotification(t, clientConn)
+	require.JSONEq(t, `false`, string(raw))
+
+	// nothing is sent once unsubscribed
+	unsub()
+	unsub()
+	time.Sleep(50 * time.Millisecond)
+	node.mu.Lock()
+	node.catchingUp = []bool{true}
+	node.mu.Unlock()
+	require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+	_, _, err = clientConn.ReadMessage()
+	require.Error(t, err)
+}
+
+// testLogsBackend serves a chain with a log in every block, the block results are served once released.
+type testLogsBackend struct {
+	rpcfilter.Backend
+
+	head    int64
+	release chan struct{}
+}
+
+func (b *testLogsBackend) HeaderByNumber(context.Context, rpc.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *testLogsBackend) CosmosBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	<-b.release
+	bz, err := json.Marshal(testLog(*height))
+	if err != nil {
+		return nil, err
+	}
+	event := abci.Event{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}}}
+	return &tmrpctypes.ResultBlockResults{Height: *height, TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{event}}}}, nil
+}
+
+func (b *testLogsBackend) BlockBloom(*tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.Bloom{}, nil
+}
+
+func (b *testLogsBackend) BloomStatus() (uint64, uint64) { return 0, 0 }
+func (b *testLogsBackend) RPCLogsCap() int32             { return 10 }
+func (b *testLogsBackend) RPCBlockRangeCap() int32       { return 10 }
+
+func testLog(height int64) *support.Log {
+	return &support.Log{Address: common.HexToAddress("0x1").Hex(), BlockNumber: uint64(height)}
+}
+
+// sendTxLogs delivers the evm tx event of a block with a log.
+func sendTxLogs(t *testing.T, wsClient *tmwsclient.WSClient, height int64) {
+	res, err := codectypes.NewAnyWithValue(&txs.MsgEthereumTxResponse{Logs: []*support.Log{testLog(height)}})
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{res}}).Marshal()
+	require.NoError(t, err)
+	sendEvent(t, wsClient, testEVMEvents, tmtypes.EventDataTx{TxResult: abci.TxResult{Height: height, Result: abci.ResponseDeliverTx{Data: data}}})
+}
+
+func TestSubscribeLogsReplay(t *testing.T) {
+	wsClient := newTestCometWSClient(t)
+	backend := &testLogsBackend{head: 3, release: make(chan struct{})}
+	api := newPubSubAPI(client.Context{}, log.Root(), wsClient, backend)
+	defer api.events.Stop()
+
+	ready := make(chan struct{})
+	close(ready)
+	conn, clientConn := newTestWSConn(t)
+	unsub, err := api.subscribe(conn, "0x1", []interface{}{"logs", map[string]interface{}{"fromBlock": "0x2"}}, ready)
+	require.NoError(t, err)
+	defer unsub()
+
+	// the live events are buffered while replaying, the ones of the replayed blocks are skipped
+	sendTxLogs(t, wsClient, 3)
+	sendTxLogs(t, wsClient, 4)
+	close(backend.release)
+
+	var blocks []uint64
+	for i := 0; i < 3; i++ {
+		_, raw := readNotification(t, clientConn)
+		var res ethtypes.Log
+		require.NoError(t, json.Unmarshal(raw, &res))
+		blocks = append(blocks, res.BlockNumber)
+	}
+	require.Equal(t, []uint64{2, 3, 4}, blocks)
+
+	require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(200*time.Millisecond)))
+	_, _, err = clientConn.ReadMessage()
+	require.Error(t, err)
+}
+
+func TestSessionShutdown(t *testing.T) {
+	conn, clientConn := newTestWSConn(t)
+	codec := newWsCodec(conn, 0, nil)
+	session := newWsSession(conn, codec, nil)
+
+	unsubscribed := make(chan struct{})
+	require.True(t, session.addSubscription("0x1", "eth", func() { close(unsubscribed) }))
+	// a request in flight
+	codec.pending.Add(1)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		session.shutdown(context.Background(), log.Root())
+	}()
+
+	// the subscriptions are cancelled and notified first
+	var notification SubscriptionErrorNotification
+	require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, clientConn.ReadJSON(&notification))
+	require.Equal(t, "eth_subscription", notification.Method)
+	require.Equal(t, rpc.ID("0x1"), notification.Params.Subscription)
+	require.Equal(t, big.NewInt(errCodeShuttingDown), notification.Params.Error.Code)
+	<-unsubscribed
+	require.False(t, session.addSubscription("0x2", "eth", func() {}))
+
+	// the connection is closed only once the request in flight is answered
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("connection closed with a request in flight")
+	default:
+	}
+	require.NoError(t, codec.encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": "0x1"}, false))
+
+	var res struct {
+		ID     int    `json:"id"`
+		Result string `json:"result"`
+	}
+	require.NoError(t, clientConn.ReadJSON(&res))
+	require.Equal(t, 1, res.ID)
+	_, _, err := clientConn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway))
+	<-done
+}
+
+// sendBatch queues a batch with its rejected responses, and returns the batch read by the rpc server.
+func sendBatch(t *testing.T, codec *wsCodec, batch string, rejected ...string) []rpcMessage {
+	var responses []json.RawMessage
+	for _, resp := range rejected {
+		responses = append(responses, json.RawMessage(resp))
+	}
+	errCh := make(chan error, 1)
+	go func() { errCh <- codec.send([]byte(batch), responses) }()
+
+	var msgs []rpcMessage
+	require.NoError(t, codec.decode(&msgs))
+	require.NoError(t, <-errCh)
+	return msgs
+}
+
+// answerBatch answers every call of a batch like the rpc server does.
+func answerBatch(t *testing.T, codec *wsCodec, msgs []rpcMessage) {
+	var resp []json.RawMessage
+	for _, msg := range msgs {
+		if msg.ID != nil {
+			resp = append(resp, json.RawMessage(`{"jsonrpc":"2.0","id":`+string(msg.ID)+`,"result":"0x1"}`))
+		}
+	}
+	require.NoError(t, codec.encode(resp, false))
+}
+
+func TestBatchRejections(t *testing.T) {
+	conn, clientConn := newTestWSConn(t)
+	codec := newWsCodec(conn, 0, newTestGuard(t, config.JSONRPCLimitsConfig{}))
+	readResponse := func() []byte {
+		require.NoError(t, clientConn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, resp, err := clientConn.ReadMessage()
+		require.NoError(t, err)
+		return resp
+	}
+
+	// the batches with the same ids are told apart by the tokens tagging them
+	batchA := sendBatch(t, codec, `[{"jsonrpc":"2.0","method":"eth_call"},{"jsonrpc":"2.0","id":1,"method":"eth_call"}]`,
+		`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"denied"}}`)
+	batchB := sendBatch(t, codec, `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":4,"method":"eth_call"}]`,
+		`{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"denied"}}`)
+	require.Nil(t, batchA[0].ID)
+	require.NotEqual(t, json.RawMessage("1"), batchA[1].ID)
+	require.NotEqual(t, json.RawMessage("1"), batchB[0].ID)
+	require.NotEqual(t, batchA[1].ID, batchB[0].ID)
+	require.Equal(t, json.RawMessage("4"), batchB[1].ID)
+
+	// the batches are answered in any order
+	answerBatch(t, codec, batchB)
+	require.Equal(t, map[string]int64{"1": 0, "4": 0, "3": errCodeMethodNotFound}, responseIDs(t, readResponse()))
+	answerBatch(t, codec, batchA)
+	require.Equal(t, map[string]int64{"1": 0, "2": errCodeMethodNotFound}, responseIDs(t, readResponse()))
+	require.False(t, codec.hasRejected())
+	require.Zero(t, codec.pendingRequests())
+
+	// the batch without a message to tag is answered apart
+	batch := sendBatch(t, codec, `[{"jsonrpc":"2.0","id":null,"method":"eth_call"}]`,
+		`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"denied"}}`)
+	require.Equal(t, map[string]int64{"2": errCodeMethodNotFound}, responseIDs(t, readResponse()))
+	require.Equal(t, json.RawMessage("null"), batch[0].ID)
+	require.False(t, codec.hasRejected())
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const BloomServiceName = "EVMBloomIndexerService"
+
+// EVMBloomIndexerService builds the bloombits index of the block blooms for json-rpc service,
+// a section is indexed once all of its blocks are committed, blocks are final so the
+// indexed sections never need to be rolled back.
+type EVMBloomIndexerService struct {
+	service.BaseService
+
+	bloomIdxr artelatypes.EVMBloomIndexer
+	client    rpcclient.Client
+}
+
+// NewEVMBloomIndexerService returns a new service instance.
+func NewEVMBloomIndexerService(
+	bloomIdxr artelatypes.EVMBloomIndexer,
+	client rpcclient.Client,
+) *EVMBloomIndexerService {
+	is := &EVMBloomIndexerService{bloomIdxr: bloomIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, BloomServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing the sections once they are complete.
+func (bis *EVMBloomIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := bis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockHeadersChan, err := bis.client.Subscribe(
+		ctx,
+		BloomServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	latestBlock := atomic.Int64{}
+	latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+	newBlockSignal := make(chan struct{}, 1)
+	go func() {
+		for {
+			select {
+			case <-bis.Quit():
+				return
+			case msg, ok := <-blockHeadersChan:
+				if !ok {
+					return
+				}
+				eventDataHeader, ok := msg.Data.(types.EventDataNewBlockHeader)
+				if !ok || eventDataHeader.Header.Height <= latestBlock.Load() {
+					continue
+				}
+				latestBlock.Store(eventDataHeader.Header.Height)
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	go bis.indexLoop(ctx, newBlockSignal, status.SyncInfo.EarliestBlockHeight, &latestBlock)
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing the new block events.
+func (bis *EVMBloomIndexerService) OnStop() {
+	if err := bis.client.UnsubscribeAll(context.Background(), BloomServiceName); err != nil {
+		bis.Logger.Error("failed to unsubscribe block events", "err", err)
+	}
+}
+
+// indexLoop indexes every section whose last block is committed, then waits for the new blocks.
+func (bis *EVMBloomIndexerService) indexLoop(ctx context.Context, newBlockSignal <-chan struct{}, earliestBlock int64, latestBlock *atomic.Int64) {
+	sectionSize := bis.bloomIdxr.SectionSize()
+	for {
+		sections, err := bis.bloomIdxr.Sections()
+		if err != nil {
+			bis.Logger.Error("failed to load indexed sections", "err", err)
+			return
+		}
+
+		lastBlock := int64((sections+1)*sectionSize) - 1 // #nosec G701
+		if latestBlock.Load() < lastBlock {
+			// the section is not complete yet. wait for signal of new block
+			select {
+			case <-bis.Quit():
+				return
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			}
+			continue
+		}
+
+		if err := bis.indexSection(ctx, sections, earliestBlock); err != nil {
+			bis.Logger.Error("failed to index bloom section", "section", sections, "err", err)
+			// back off a bit before retrying
+			select {
+			case <-bis.Quit():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		bis.Logger.Debug("indexed bloom section", "section", sections)
+	}
+}
+
+// indexSection collects the blooms of the blocks in a section and indexes them. The blocks
+// before the earliest block kept by the node, including the genesis, have no logs to be
+// found, so they are indexed with empty blooms.
+func (bis *EVMBloomIndexerService) indexSection(ctx context.Context, section uint64, earliestBlock int64) error {
+	sectionSize := bis.bloomIdxr.SectionSize()
+	blooms := make([]ethtypes.Bloom, sectionSize)
+	for i := range blooms {
+		height := int64(section*sectionSize) + int64(i) // #nosec G701
+		if height < earliestBlock || height == 0 {
+			continue
+		}
+
+		select {
+		case <-bis.Quit():
+			return nil
+		default:
+		}
+
+		blockResult, err := bis.client.BlockResults(ctx, &height)
+		if err != nil {
+			return err
+		}
+		blooms[i] = rpctypes.BlockBloomFromEvents(blockResult.EndBlockEvents)
+	}
+
+	select {
+	case <-bis.Quit():
+		return nil
+	default:
+	}
+	return bis.bloomIdxr.IndexSection(section, blooms)
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/indexer"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// blockResultsClient serves the block results with the given blooms.
+type blockResultsClient struct {
+	rpcclient.Client
+
+	blooms  map[int64]ethtypes.Bloom
+	failAt  int64
+	fetched map[int64]bool
+}
+
+func (c *blockResultsClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	c.fetched[*height] = true
+	if *height == c.failAt {
+		return nil, errors.New("block results not available")
+	}
+
+	res := &coretypes.ResultBlockResults{Height: *height}
+	if bloom, ok := c.blooms[*height]; ok {
+		res.EndBlockEvents = []abci.Event{{
+			Type: evmtypes.EventTypeBlockBloom,
+			Attributes: []abci.EventAttribute{{
+				Key:   evmtypes.AttributeKeyEthereumBloom,
+				Value: base64.StdEncoding.EncodeToString(bloom.Bytes()),
+			}},
+		}}
+	}
+	return res, nil
+}
+
+func TestBloomIndexerServiceIndexSection(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	var bloom ethtypes.Bloom
+	bloom.Add(addr.Bytes())
+
+	testCases := []struct {
+		name          string
+		earliestBlock int64
+		failAt        int64
+		expErr        bool
+	}{
+		{"success, whole section kept", 0, -1, false},
+		{"success, blocks before earliest block skipped", 3, 2, false},
+		{"fail, block results not available", 0, 7, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewBloomIndexer(dbm.NewMemDB(), log.NewNopLogger())
+			client := &blockResultsClient{
+				blooms:  map[int64]ethtypes.Bloom{10: bloom},
+				failAt:  tc.failAt,
+				fetched: make(map[int64]bool),
+			}
+			bis := NewEVMBloomIndexerService(idxer, client)
+
+			err := bis.indexSection(context.Background(), 0, tc.earliestBlock)
+			sections, sectionsErr := idxer.Sections()
+			require.NoError(t, sectionsErr)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Equal(t, uint64(0), sections)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), sections)
+
+			// the genesis and the pruned blocks are not fetched
+			require.False(t, client.fetched[0])
+			for height := int64(1); height < tc.earliestBlock; height++ {
+				require.False(t, client.fetched[height])
+			}
+			require.True(t, client.fetched[int64(idxer.SectionSize())-1])
+
+			// the bloom of the block is indexed
+			for _, bit := range bloomBits(bloom) {
+				compVector, err := idxer.BloomBits(bit, 0)
+				require.NoError(t, err)
+				vector, err := bitutil.DecompressBytes(compVector, int(idxer.SectionSize()/8))
+				require.NoError(t, err)
+				require.NotZero(t, vector[10/8]&(0x80>>(10%8)))
+			}
+		})
+	}
+}
+
+// bloomBits returns the bloom bits set in the bloom, numbered as the bloombits generator does.
+func bloomBits(bloom ethtypes.Bloom) []uint {
+	var bits []uint
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		if bloom[ethtypes.BloomByteLength-1-bit/8]&(1<<(bit%8)) != 0 {
+			bits = append(bits, bit)
+		}
+	}
+	return bits
+}
@@ -3,7 +3,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
+	"strconv"
+	gostrings "strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -47,6 +50,9 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum price bump percentage to replace a tx in the app-side mempool
+	DefaultMempoolPriceBump = 10
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -69,8 +75,25 @@ const (
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
+	// DefaultAllowInsecureUnlock value is false
+	DefaultAllowInsecureUnlock = false
+
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultWsMaxPendingRequests is the default number of requests a websocket connection can have in flight
+	DefaultWsMaxPendingRequests = 100
+
+	DefaultWsWriteTimeout = 10 * time.Second
+
+	// DefaultShutdownTimeout is the default time given to the in-flight requests to finish on shutdown
+	DefaultShutdownTimeout = 10 * time.Second
+
+	// DefaultMaxBatchSize is the default maximum number of requests in a JSON-RPC batch
+	DefaultMaxBatchSize = 1000
+
+	// DefaultMaxResponseSize is the default maximum size in bytes of a JSON-RPC response, 25MB
+	DefaultMaxResponseSize = 25 * 1024 * 1024
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -93,6 +116,11 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth txs returned in ante handler in check txs mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-txs-gas-wanted"`
+	// EnableMempool defines if the app-side mempool queueing the txs by sender and nonce is enabled.
+	EnableMempool bool `mapstructure:"enable-mempool"`
+	// MempoolPriceBump defines the minimum price bump percentage to replace an ethereum tx
+	// with the same nonce in the app-side mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
 }
 
 // AspectConfig defines the application configuration values for Aspect.
@@ -101,6 +129,8 @@ type AspectConfig struct {
 	ApplyPoolSize int32
 	// QueryPoolSize defines capacity of aspect runtime instance pool for querying txs
 	QueryPoolSize int32
+	// EnableProposalHandler defines if the aspect-aware block proposal handler is enabled
+	EnableProposalHandler bool
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -134,15 +164,57 @@ type JSONRPCConfig struct {
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
+	// AllowInsecureUnlock allows the accounts of the keyring backends which are not protected
+	// by a passphrase (e.g. test) to be unlocked via the node's RPC, for development only.
+	AllowInsecureUnlock bool `mapstructure:"allow-insecure-unlock"`
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// WsMaxPendingRequests sets the maximum number of requests a websocket connection
+	// can have in flight, the requests exceeding it are rejected (unlimited = 0).
+	WsMaxPendingRequests int `mapstructure:"ws-max-pending-requests"`
+	// WsWriteTimeout is the write timeout of the websocket server connections.
+	WsWriteTimeout time.Duration `mapstructure:"ws-write-timeout"`
+	// ShutdownTimeout is the maximum time given to the in-flight requests and the
+	// subscriptions to finish when the JSON-RPC server shuts down, zero means the default.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableBloomIndexer defines if enable the bloombits indexer for `eth_getLogs` queries.
+	EnableBloomIndexer bool `mapstructure:"enable-bloom-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when txs reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// Limits defines the access rules and the limits of the JSON-RPC requests.
+	Limits JSONRPCLimitsConfig `mapstructure:"limits"`
+}
+
+// JSONRPCLimitsConfig defines the access rules and the limits enforced on the JSON-RPC requests
+// served over HTTP and websockets. The rules name either a method, e.g. "debug_traceTransaction",
+// or a whole namespace, e.g. "debug".
+type JSONRPCLimitsConfig struct {
+	// AllowList lists the methods or namespaces served, all are served if empty.
+	AllowList []string `mapstructure:"allow-list"`
+	// DenyList lists the methods or namespaces rejected, it takes precedence over AllowList.
+	DenyList []string `mapstructure:"deny-list"`
+	// RateLimit sets the number of requests per second a client IP can make (unlimited = 0).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateBurst sets the number of requests a client IP can make at once, it defaults to
+	// the rate limit if 0.
+	RateBurst int `mapstructure:"rate-burst"`
+	// MethodRateLimits sets the number of requests per second a client IP can make to a method
+	// or namespace, on top of RateLimit, formatted as "<method or namespace>=<requests per second>".
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// MaxBatchSize sets the maximum number of requests in a batch (unlimited = 0).
+	MaxBatchSize int `mapstructure:"max-batch-size"`
+	// MaxResponseSize sets the maximum size in bytes of a response (unlimited = 0), the
+	// responses of a batch exceeding it are replaced by errors.
+	MaxResponseSize int `mapstructure:"max-response-size"`
+	// TrustedProxies lists the IPs or CIDRs of the reverse proxies trusted to set the client IP
+	// in the X-Forwarded-For header. The client IP is the remote address of the connection if
+	// empty, so that all the clients behind a proxy share the rate limits.
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -275,8 +347,9 @@ func DefaultServerConfig() *config.Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:           DefaultEVMTracer,
+		MaxTxGasWanted:   DefaultMaxTxGasWanted,
+		MempoolPriceBump: DefaultMempoolPriceBump,
 	}
 }
 
@@ -317,7 +390,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "aspect"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -337,13 +410,109 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
+		AllowInsecureUnlock:      DefaultAllowInsecureUnlock,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
+		WsMaxPendingRequests:     DefaultWsMaxPendingRequests,
+		WsWriteTimeout:           DefaultWsWriteTimeout,
+		ShutdownTimeout:          DefaultShutdownTimeout,
 		EnableIndexer:            false,
+		EnableBloomIndexer:       false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		Limits:                   *DefaultJSONRPCLimitsConfig(),
+	}
+}
+
+// DefaultJSONRPCLimitsConfig returns the default JSON-RPC limits, all methods are served without rate limits.
+func DefaultJSONRPCLimitsConfig() *JSONRPCLimitsConfig {
+	return &JSONRPCLimitsConfig{
+		AllowList:        []string{},
+		DenyList:         []string{},
+		MethodRateLimits: []string{},
+		MaxBatchSize:     DefaultMaxBatchSize,
+		MaxResponseSize:  DefaultMaxResponseSize,
+		TrustedProxies:   []string{},
 	}
 }
 
+// Validate returns an error if the JSON-RPC limits are invalid.
+func (c JSONRPCLimitsConfig) Validate() error {
+	for _, rule := range append(append([]string{}, c.AllowList...), c.DenyList...) {
+		if rule == "" || gostrings.ContainsAny(rule, " \t=") {
+			return fmt.Errorf("invalid JSON-RPC method or namespace '%s'", rule)
+		}
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateBurst < 0 {
+		return errors.New("JSON-RPC rate burst cannot be negative")
+	}
+
+	if _, err := c.MethodRates(); err != nil {
+		return err
+	}
+
+	if c.MaxBatchSize < 0 {
+		return errors.New("JSON-RPC max batch size cannot be negative")
+	}
+
+	if c.MaxResponseSize < 0 {
+		return errors.New("JSON-RPC max response size cannot be negative")
+	}
+
+	if _, err := c.TrustedProxyNets(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TrustedProxyNets parses the IPs and CIDRs of the trusted proxies.
+func (c JSONRPCLimitsConfig) TrustedProxyNets() ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(c.TrustedProxies))
+	for _, proxy := range c.TrustedProxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC trusted proxy '%s', expected an IP or a CIDR", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// MethodRates parses the rate limits of the methods and namespaces.
+func (c JSONRPCLimitsConfig) MethodRates() (map[string]float64, error) {
+	rates := make(map[string]float64, len(c.MethodRateLimits))
+	for _, limit := range c.MethodRateLimits {
+		rule, value, ok := gostrings.Cut(limit, "=")
+		if !ok || rule == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', expected '<method or namespace>=<requests per second>'", limit)
+		}
+
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', the rate must be a positive number", limit)
+		}
+
+		if _, ok := rates[rule]; ok {
+			return nil, fmt.Errorf("repeated JSON-RPC method rate limit '%s'", rule)
+		}
+		rates[rule] = rate
+	}
+	return rates, nil
+}
+
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
 func (c JSONRPCConfig) Validate() error {
 	if c.Enable && len(c.API) == 0 {
@@ -382,6 +551,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WsMaxPendingRequests < 0 {
+		return errors.New("JSON-RPC websocket max pending requests cannot be negative")
+	}
+
+	if c.WsWriteTimeout < 0 {
+		return errors.New("JSON-RPC websocket write timeout duration cannot be negative")
+	}
+
+	if c.ShutdownTimeout < 0 {
+		return errors.New("JSON-RPC shutdown timeout duration cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -392,7 +573,7 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
-	return nil
+	return c.Limits.Validate()
 }
 
 // DefaultTLSConfig returns the default TLS configuration
@@ -430,8 +611,10 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-txs-gas-wanted"),
+			Tracer:           v.GetString("evm.tracer"),
+			MaxTxGasWanted:   v.GetUint64("evm.max-txs-gas-wanted"),
+			EnableMempool:    v.GetBool("evm.enable-mempool"),
+			MempoolPriceBump: v.GetUint64("evm.mempool-price-bump"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -448,18 +631,34 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
+			WsMaxPendingRequests:     v.GetInt("json-rpc.ws-max-pending-requests"),
+			WsWriteTimeout:           v.GetDuration("json-rpc.ws-write-timeout"),
+			ShutdownTimeout:          v.GetDuration("json-rpc.shutdown-timeout"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			EnableBloomIndexer:       v.GetBool("json-rpc.enable-bloom-indexer"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			AllowUnprotectedTxs:      v.GetBool("json-rpc.allow-unprotected-txs"),
+			AllowInsecureUnlock:      v.GetBool("json-rpc.allow-insecure-unlock"),
+			Limits: JSONRPCLimitsConfig{
+				AllowList:        v.GetStringSlice("json-rpc.limits.allow-list"),
+				DenyList:         v.GetStringSlice("json-rpc.limits.deny-list"),
+				RateLimit:        v.GetFloat64("json-rpc.limits.rate-limit"),
+				RateBurst:        v.GetInt("json-rpc.limits.rate-burst"),
+				MethodRateLimits: v.GetStringSlice("json-rpc.limits.method-rate-limits"),
+				MaxBatchSize:     v.GetInt("json-rpc.limits.max-batch-size"),
+				MaxResponseSize:  v.GetInt("json-rpc.limits.max-response-size"),
+				TrustedProxies:   v.GetStringSlice("json-rpc.limits.trusted-proxies"),
+			},
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
 		Aspect: AspectConfig{
-			ApplyPoolSize: v.GetInt32("aspect.apply-pool-size"),
-			QueryPoolSize: v.GetInt32("aspect.query-pool-size"),
+			ApplyPoolSize:         v.GetInt32("aspect.apply-pool-size"),
+			QueryPoolSize:         v.GetInt32("aspect.query-pool-size"),
+			EnableProposalHandler: v.GetBool("aspect.enable-proposal-handler"),
 		},
 	}, nil
 }
@@ -16,6 +16,14 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth txs returned in ante handler in check txs mode.
 max-txs-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# EnableMempool defines if the app-side mempool queueing the txs by sender and nonce is enabled,
+# the number of txs it keeps is limited by 'mempool.max-txs'.
+enable-mempool = {{ .EVM.EnableMempool }}
+
+# MempoolPriceBump defines the minimum price bump percentage to replace an ethereum tx with
+# the same nonce in the app-side mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -31,7 +39,8 @@ address = "{{ .JSONRPC.Address }}"
 # Address defines the EVM WebSocket server address to bind to.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
-# API defines a list of JSON-RPC namespaces that should be enabled
+# API defines a list of JSON-RPC namespaces that should be enabled, the debug namespace is only
+# served over websocket, and the personal namespace over HTTP and websocket, if listed here.
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
@@ -66,20 +75,76 @@ http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 
+# AllowInsecureUnlock allows the accounts of the keyring backends which are not protected
+# by a passphrase (e.g. test) to be unlocked via the node's RPC, for development only.
+allow-insecure-unlock = {{ .JSONRPC.AllowInsecureUnlock }}
+
 # MaxOpenConnections sets the maximum number of simultaneous connections
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
+# WsMaxPendingRequests sets the maximum number of requests a websocket connection can have
+# in flight, the requests exceeding it are rejected (unlimited = 0).
+ws-max-pending-requests = {{ .JSONRPC.WsMaxPendingRequests }}
+
+# WsWriteTimeout is the write timeout of the websocket server connections.
+ws-write-timeout = "{{ .JSONRPC.WsWriteTimeout }}"
+
+# ShutdownTimeout is the maximum time given to the in-flight requests and the subscriptions
+# to finish when the JSON-RPC server shuts down, 0 uses the default of 10s.
+shutdown-timeout = "{{ .JSONRPC.ShutdownTimeout }}"
+
 # EnableIndexer enables the custom txs indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableBloomIndexer enables the bloombits indexer of the block blooms, which accelerates 'eth_getLogs'
+# queries over large block ranges, the block range cap only applies to the blocks not indexed yet.
+enable-bloom-indexer = {{ .JSONRPC.EnableBloomIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
+# The metrics cover the JSON-RPC requests, subscriptions and filters, the EVM transactions,
+# the aspect runner pools and join point executions, and the VerifySigCache lookups.
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 
 # Upgrade height for fix of revert gas refund logic when txs reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+[json-rpc.limits]
+
+# AllowList defines the methods or namespaces served over HTTP and websockets, all are served if empty.
+# The rules name either a method or a whole namespace.
+# Example: "eth,net,web3,debug_traceTransaction"
+allow-list = "{{range $index, $elmt := .JSONRPC.Limits.AllowList}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DenyList defines the methods or namespaces rejected, it takes precedence over the allow list.
+# Example: "debug,eth_getLogs"
+deny-list = "{{range $index, $elmt := .JSONRPC.Limits.DenyList}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimit sets the number of requests per second a client IP can make (unlimited = 0).
+rate-limit = {{ .JSONRPC.Limits.RateLimit }}
+
+# RateBurst sets the number of requests a client IP can make at once, it defaults to the rate limit if 0.
+rate-burst = {{ .JSONRPC.Limits.RateBurst }}
+
+# MethodRateLimits sets the number of requests per second a client IP can make to a method or namespace,
+# on top of the rate limit.
+# Example: "eth_getLogs=10,debug=1"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.Limits.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MaxBatchSize sets the maximum number of requests in a batch (unlimited = 0).
+max-batch-size = {{ .JSONRPC.Limits.MaxBatchSize }}
+
+# MaxResponseSize sets the maximum size in bytes of a response (unlimited = 0), the responses
+# of a batch exceeding it are replaced by errors.
+max-response-size = {{ .JSONRPC.Limits.MaxResponseSize }}
+
+# TrustedProxies defines the IPs or CIDRs of the reverse proxies trusted to set the client IP in the
+# X-Forwarded-For header. The client IP is the remote address of the connection if empty, so that all
+# the clients behind a proxy share the rate limits.
+# Example: "127.0.0.1,10.0.0.0/8"
+trusted-proxies = "{{range $index, $elmt := .JSONRPC.Limits.TrustedProxies}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -98,4 +163,8 @@ key-path = "{{ .TLS.KeyPath }}"
 [aspect]
 apply-pool-size = {{ .Aspect.ApplyPoolSize }}
 query-pool-size = {{ .Aspect.QueryPoolSize }}
+
+# EnableProposalHandler enables the aspect-aware block proposal handler, which orders the txs by
+# effective tip within the block gas limit, and places the txs submitted by aspects in the proposal.
+enable-proposal-handler = {{ .Aspect.EnableProposalHandler }}
 `
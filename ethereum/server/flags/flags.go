@@ -36,21 +36,26 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable              = "json-rpc.enable"
-	JSONRPCAPI                 = "json-rpc.api"
-	JSONRPCAddress             = "json-rpc.address"
-	JSONWsAddress              = "json-rpc.ws-address"
-	JSONRPCGasCap              = "json-rpc.gas-cap"
-	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
-	JSONRPCFilterCap           = "json-rpc.filter-cap"
-	JSONRPCLogsCap             = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnable               = "json-rpc.enable"
+	JSONRPCAPI                  = "json-rpc.api"
+	JSONRPCAddress              = "json-rpc.address"
+	JSONWsAddress               = "json-rpc.ws-address"
+	JSONRPCGasCap               = "json-rpc.gas-cap"
+	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
+	JSONRPCFilterCap            = "json-rpc.filter-cap"
+	JSONRPCLogsCap              = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
+	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
+	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
+	JSONRPCWsMaxPendingRequests = "json-rpc.ws-max-pending-requests"
+	JSONRPCWsWriteTimeout       = "json-rpc.ws-write-timeout"
+	JSONRPCShutdownTimeout      = "json-rpc.shutdown-timeout"
+	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableBloomIndexer   = "json-rpc.enable-bloom-indexer"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -58,16 +63,31 @@ const (
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
 )
 
+// JSON-RPC limits flags
+const (
+	JSONRPCAllowList        = "json-rpc.limits.allow-list"
+	JSONRPCDenyList         = "json-rpc.limits.deny-list"
+	JSONRPCRateLimit        = "json-rpc.limits.rate-limit"
+	JSONRPCRateBurst        = "json-rpc.limits.rate-burst"
+	JSONRPCMethodRateLimits = "json-rpc.limits.method-rate-limits"
+	JSONRPCMaxBatchSize     = "json-rpc.limits.max-batch-size"
+	JSONRPCMaxResponseSize  = "json-rpc.limits.max-response-size"
+	JSONRPCTrustedProxies   = "json-rpc.limits.trusted-proxies"
+)
+
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-txs-gas-wanted"
+	EVMTracer           = "evm.tracer"
+	EVMMaxTxGasWanted   = "evm.max-txs-gas-wanted"
+	EVMEnableMempool    = "evm.enable-mempool"
+	EVMMempoolPriceBump = "evm.mempool-price-bump"
 )
 
 // Aspect flags
 const (
-	ApplyPoolSize = "aspect.apply-pool-size"
-	QueryPoolSize = "aspect.query-pool-size"
+	ApplyPoolSize         = "aspect.apply-pool-size"
+	QueryPoolSize         = "aspect.query-pool-size"
+	EnableProposalHandler = "aspect.enable-proposal-handler"
 )
 
 // TLS flags
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+)
+
+// EVMIndexerService indexes transactions for json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr artelatypes.EVMTxIndexer
+	client rpcclient.Client
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr artelatypes.EVMTxIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing them by events.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	// Use an unbuffered subscription to ensure the subscription does not get
+	// canceled due to not pulling messages fast enough, headers are drained
+	// in a dedicated goroutine so the event bus is never blocked by indexing.
+	latestBlock := atomic.Int64{}
+	latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+	newBlockSignal := make(chan struct{}, 1)
+	go func() {
+		for {
+			select {
+			case <-eis.Quit():
+				return
+			case msg, ok := <-blockHeadersChan:
+				if !ok {
+					return
+				}
+				eventDataHeader, ok := msg.Data.(types.EventDataNewBlockHeader)
+				if !ok || eventDataHeader.Header.Height <= latestBlock.Load() {
+					continue
+				}
+				latestBlock.Store(eventDataHeader.Header.Height)
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		// empty indexer db, index from the earliest block still kept by the node
+		lastBlock = status.SyncInfo.EarliestBlockHeight - 1
+		if lastBlock < 0 {
+			lastBlock = 0
+		}
+	}
+
+	go eis.indexLoop(ctx, newBlockSignal, lastBlock, &latestBlock)
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing the new block events.
+func (eis *EVMIndexerService) OnStop() {
+	if err := eis.client.UnsubscribeAll(context.Background(), ServiceName); err != nil {
+		eis.Logger.Error("failed to unsubscribe block events", "err", err)
+	}
+}
+
+// indexLoop catches up from lastBlock to the latest block, then indexes every new block
+// once it is signaled.
+func (eis *EVMIndexerService) indexLoop(ctx context.Context, newBlockSignal <-chan struct{}, lastBlock int64, latestBlock *atomic.Int64) {
+	for {
+		if latestBlock.Load() <= lastBlock {
+			// nothing to index. wait for signal of new block
+			select {
+			case <-eis.Quit():
+				return
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			}
+			continue
+		}
+
+		for i := lastBlock + 1; i <= latestBlock.Load(); i++ {
+			block, err := eis.client.Block(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
+				break
+			}
+			blockResult, err := eis.client.BlockResults(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
+				break
+			}
+			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+				eis.Logger.Error("failed to index block", "height", i, "err", err)
+				break
+			}
+			lastBlock = blockResult.Height
+		}
+
+		// back off a bit before retrying blocks that failed to be fetched or indexed
+		if latestBlock.Load() > lastBlock {
+			select {
+			case <-eis.Quit():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
@@ -36,10 +36,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	gethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
+	"github.com/artela-network/artela/ethereum/indexer"
 	"github.com/artela-network/artela/ethereum/rpc"
 	"github.com/artela-network/artela/ethereum/server/config"
 	artelaflag "github.com/artela-network/artela/ethereum/server/flags"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
 	aspecttypes "github.com/artela-network/aspect-core/types"
 )
 
@@ -218,21 +222,38 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Duration(artelaflag.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(artelaflag.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(artelaflag.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
-	cmd.Flags().Bool(artelaflag.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
+	cmd.Flags().Bool(artelaflag.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled")                  //nolint:lll
+	cmd.Flags().Bool(artelaflag.JSONRPCAllowInsecureUnlock, config.DefaultAllowInsecureUnlock, "Allow for the accounts of the keyring backends not protected by a passphrase (e.g. test) to be unlocked via the node's RPC, for development only") //nolint:lll
 	cmd.Flags().Int32(artelaflag.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(artelaflag.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
-	cmd.Flags().Int(artelaflag.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int(artelaflag.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener")                     //nolint:lll
+	cmd.Flags().Int(artelaflag.JSONRPCWsMaxPendingRequests, config.DefaultWsMaxPendingRequests, "Sets the maximum number of requests a websocket connection can have in flight (0=unlimited)") //nolint:lll
+	cmd.Flags().Duration(artelaflag.JSONRPCWsWriteTimeout, config.DefaultWsWriteTimeout, "Sets a write timeout for json-rpc websocket connections (0=infinite)")
+	cmd.Flags().Duration(artelaflag.JSONRPCShutdownTimeout, config.DefaultShutdownTimeout, "Sets the maximum time given to the in-flight json-rpc requests to finish on shutdown")
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableBloomIndexer, false, "Enable the bloombits indexer to accelerate `eth_getLogs` over large block ranges")
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
+	cmd.Flags().StringSlice(artelaflag.JSONRPCAllowList, []string{}, "Defines the json-rpc methods or namespaces served, all are served if empty")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCDenyList, []string{}, "Defines the json-rpc methods or namespaces rejected, it takes precedence over the allow list")
+	cmd.Flags().Float64(artelaflag.JSONRPCRateLimit, 0, "Sets the number of json-rpc requests per second a client IP can make (0=unlimited)")
+	cmd.Flags().Int(artelaflag.JSONRPCRateBurst, 0, "Sets the number of json-rpc requests a client IP can make at once (0=rate limit)")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCMethodRateLimits, []string{}, "Sets the json-rpc requests per second a client IP can make to a method or namespace, e.g. eth_getLogs=10")
+	cmd.Flags().Int(artelaflag.JSONRPCMaxBatchSize, config.DefaultMaxBatchSize, "Sets the maximum number of requests in a json-rpc batch (0=unlimited)")
+	cmd.Flags().Int(artelaflag.JSONRPCMaxResponseSize, config.DefaultMaxResponseSize, "Sets the maximum size in bytes of a json-rpc response (0=unlimited)")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCTrustedProxies, []string{}, "Defines the IPs or CIDRs of the reverse proxies trusted to set the json-rpc client IP in the X-Forwarded-For header")
+
 	cmd.Flags().String(artelaflag.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(artelaflag.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(artelaflag.EVMEnableMempool, false, "the app-side mempool queueing the txs by sender and nonce is enabled")                                                               //nolint:lll
+	cmd.Flags().Uint64(artelaflag.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum price bump percentage to replace an eth tx in the app-side mempool")                       //nolint:lll
 
 	cmd.Flags().String(artelaflag.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(artelaflag.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
 	cmd.Flags().Uint64(artelaflag.ApplyPoolSize, aspecttypes.DefaultAspectPoolSize, "the cache pool size for runtime instances for applying message")
 	cmd.Flags().Uint64(artelaflag.QueryPoolSize, aspecttypes.DefaultAspectPoolSize, "the cache pool size for runtime instances for querying message")
+	cmd.Flags().Bool(artelaflag.EnableProposalHandler, false, "Enable the aspect-aware block proposal handler")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -454,10 +475,74 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 		}
 	}
 
+	var idxer artelatypes.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer && tmNode != nil {
+		genDoc, err := genDocProvider()
+		if err != nil {
+			return err
+		}
+
+		idxDB, err := OpenIndexerDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		kvIndexer, err := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx.WithChainID(genDoc.ChainID))
+		if err != nil {
+			return err
+		}
+		idxer = kvIndexer
+
+		indexerService := NewEVMIndexerService(idxer, local.New(tmNode))
+		indexerService.SetLogger(idxLogger)
+		if err := indexerService.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = indexerService.Stop()
+			if err := idxDB.Close(); err != nil {
+				ctx.Logger.Error("failed to close evm indexer DB", "error", err.Error())
+			}
+		}()
+	}
+
+	var bloomIdxer artelatypes.EVMBloomIndexer
+	if config.JSONRPC.EnableBloomIndexer && tmNode != nil {
+		bloomDB, err := OpenBloomIndexerDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open evm bloom indexer DB", "error", err.Error())
+			return err
+		}
+
+		bloomLogger := ctx.Logger.With("indexer", "evm-bloom")
+		bloomIndexer := indexer.NewBloomIndexer(bloomDB, bloomLogger)
+		bloomIdxer = bloomIndexer
+
+		bloomIndexerService := NewEVMBloomIndexerService(bloomIndexer, local.New(tmNode))
+		bloomIndexerService.SetLogger(bloomLogger)
+		if err := bloomIndexerService.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = bloomIndexerService.Stop()
+			if err := bloomDB.Close(); err != nil {
+				ctx.Logger.Error("failed to close evm bloom indexer DB", "error", err.Error())
+			}
+		}()
+	}
+
 	var (
 		jsonrpcSrv *rpc.ArtelaService
 		errCh      chan error = make(chan error)
 	)
+	// serve the metrics of the json-rpc, the evm and the aspect runtime in prometheus format
+	// if enabled with --metrics
+	if gethmetrics.Enabled {
+		gethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
 	if config.JSONRPC.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
@@ -469,10 +554,19 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config)
+		// the app-side mempool is exposed to the json-rpc backend if enabled by the app
+		var evmMempool artelatypes.EVMMempool
+		if mempoolApp, ok := app.(interface{ EVMMempool() artelatypes.EVMMempool }); ok {
+			evmMempool = mempoolApp.EVMMempool()
+		}
+
+		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, bloomIdxer, evmMempool)
 		if err != nil {
 			return err
 		}
+		if tmNode != nil {
+			jsonrpcSrv.SetMempool(tmNode.Mempool())
+		}
 
 		go func() {
 			// wait for the start of the RPC server.
@@ -573,6 +667,13 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 	}
 
 	defer func() {
+		// stop serving the json-rpc clients before the node and the app go away
+		if jsonrpcSrv != nil {
+			if err := jsonrpcSrv.Shutdown(); err != nil {
+				ctx.Logger.Error("failed to shutdown JSON-RPC server", "error", err.Error())
+			}
+		}
+
 		if tmNode != nil && tmNode.IsRunning() {
 			_ = tmNode.Stop()
 			_ = app.Close()
@@ -586,10 +687,6 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 			_ = apiSrv.Close()
 		}
 
-		if jsonrpcSrv != nil {
-			_ = jsonrpcSrv.Shutdown()
-		}
-
 		ctx.Logger.Info("exiting...")
 	}()
 
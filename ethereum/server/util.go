@@ -22,6 +22,7 @@ import (
 
 	ethrpc "github.com/artela-network/artela/ethereum/rpc"
 	"github.com/artela-network/artela/ethereum/server/config"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
 )
 
 // add server commands
@@ -60,6 +61,9 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 	tmRPCAddr,
 	tmEndpoint string,
 	config *config.Config,
+	indexer artelatypes.EVMTxIndexer,
+	bloomIndexer artelatypes.EVMBloomIndexer,
+	evmMempool artelatypes.EVMMempool,
 ) (*ethrpc.ArtelaService, error) {
 	cfg := ethrpc.DefaultConfig()
 	cfg.RPCGasCap = config.JSONRPC.GasCap
@@ -95,19 +99,27 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 	}))
 	// do not start websocket
 	nodeCfg.WSHost = ""
-	stack, err := ethrpc.NewNode(nodeCfg)
+	ethrpc.EnableAPIs(nodeCfg, config.JSONRPC.API)
+
+	// the access rules and limits are shared by the http and websocket requests
+	guard, err := ethrpc.NewRequestGuard(config.JSONRPC.Limits)
+	if err != nil {
+		return nil, err
+	}
+	stack, err := ethrpc.NewNode(nodeCfg, guard)
 	if err != nil {
 		return nil, err
 	}
 
 	wsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
 
-	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger)
+	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger, indexer, bloomIndexer, evmMempool)
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
-	wsSrv := ethrpc.NewWebsocketsServer(clientCtx, tmWsClient, config, nodeCfg.Logger)
+	wsSrv := ethrpc.NewWebsocketsServer(clientCtx, tmWsClient, config, stack, guard, serv.Backend(), nodeCfg.Logger)
 	wsSrv.Start()
+	serv.SetWebsocketsServer(wsSrv)
 
 	return serv, nil
 }
@@ -117,6 +129,18 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	return dbm.NewDB("application", backendType, dataDir)
 }
 
+// OpenBloomIndexerDB opens the eth bloombits index db, using the same db backend as the main app
+func OpenBloomIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmbloom", backendType, dataDir)
+}
+
+// OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	if traceWriterFile == "" {
 		return
@@ -145,7 +169,7 @@ func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger ethlog.Logger) *rpcclient.
 			"address", tmRPCAddr+tmEndpoint,
 			"error", err,
 		)
-	} else if err := tmWsClient.OnStart(); err != nil {
+	} else if err := tmWsClient.Start(); err != nil {
 		logger.Error(
 			"Tendermint WS client could not start",
 			"address", tmRPCAddr+tmEndpoint,
@@ -4,6 +4,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth txs indexer.
@@ -17,3 +18,17 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if txs not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMBloomIndexer defines the interface of the bloombits index of the block blooms,
+// the blooms are rotated into sections of bit vectors to search the logs over large block ranges.
+type EVMBloomIndexer interface {
+	// SectionSize returns the number of blocks of a section.
+	SectionSize() uint64
+	// Sections returns the number of sections which are fully indexed.
+	Sections() (uint64, error)
+	// IndexSection indexes the blooms of a section, the blooms must be ordered by height and
+	// the section must be the next one to be indexed.
+	IndexSection(section uint64, blooms []ethtypes.Bloom) error
+	// BloomBits returns the compressed bit vector of a bloom bit in a section.
+	BloomBits(bit uint, section uint64) ([]byte, error)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EVMMempool defines the interface of the app-side mempool exposed to the json-rpc backend,
+// which keeps the ethereum txs queued by sender and nonce.
+type EVMMempool interface {
+	// PendingNonce returns the next nonce of the sender, taking the executable txs of
+	// the sender in the mempool into account on top of the state nonce.
+	PendingNonce(sender common.Address, stateNonce uint64) uint64
+	// EthTxsBySender returns the ethereum txs in the mempool, sorted by nonce for every sender.
+	EthTxsBySender() map[common.Address]ethtypes.Transactions
+}
@@ -154,12 +154,12 @@ func startInProcess(cfg Config, val *Validator) error {
 		}
 		// do not start websocket
 		nodeCfg.WSHost = ""
-		node, err := rpc2.NewNode(nodeCfg)
+		node, err := rpc2.NewNode(nodeCfg, nil)
 		if err != nil {
 			panic(err)
 		}
 
-		val.artelaService = rpc2.NewArtelaService(val.Ctx, val.ClientCtx, nil, cfg, node, log.Root())
+		val.artelaService = rpc2.NewArtelaService(val.Ctx, val.ClientCtx, nil, cfg, node, log.Root(), nil, nil, nil)
 		startErr := val.artelaService.Start()
 		if startErr != nil {
 			return startErr
@@ -60,8 +60,12 @@ func (c *AspectNativeContract) register(handler Handler) {
 func (c *AspectNativeContract) ApplyMessage(ctx sdk.Context, msg *core.Message, gas uint64, commit bool) (ret []byte, remainingGas uint64, err error) {
 	var writeCacheFunc func()
 	ctx, writeCacheFunc = ctx.CacheContext()
+	// events emitted by a failed call must be reverted along with the cached store changes
+	snapshot := c.evmState.Snapshot()
 	ret, remainingGas, err = c.applyMsg(ctx, msg, gas, commit)
-	if err == nil && commit {
+	if err != nil {
+		c.evmState.RevertToSnapshot(snapshot)
+	} else if commit {
 		writeCacheFunc()
 	}
 
@@ -95,6 +99,7 @@ func (c *AspectNativeContract) applyMsg(ctx sdk.Context, msg *core.Message, gas
 		msg.GasPrice,
 		msg.GasTipCap,
 		msg.GasFeeCap,
+		*msg.To,
 	}
 
 	return handler.Handle(handlerCtx, gas)
@@ -0,0 +1,54 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// genesisSection maps an aspect store prefix to its entries in the genesis state
+type genesisSection struct {
+	prefix  string
+	entries *[]support.AspectStoreEntry
+}
+
+func genesisSections(aspects *support.GenesisAspects) []genesisSection {
+	return []genesisSection{
+		{types.AspectCodeKeyPrefix, &aspects.Codes},
+		{types.AspectCodeVersionKeyPrefix, &aspects.Versions},
+		{types.AspectPropertyKeyPrefix, &aspects.Properties},
+		{types.ContractBindKeyPrefix, &aspects.ContractBindings},
+		{types.VerifierBindingKeyPrefix, &aspects.VerifierBindings},
+		{types.AspectRefKeyPrefix, &aspects.Refs},
+		{types.AspectJoinPointRunKeyPrefix, &aspects.JoinPoints},
+		{types.AspectStateKeyPrefix, &aspects.States},
+	}
+}
+
+// ExportGenesis exports all the entries of the aspect store.
+func (k *AspectStore) ExportGenesis(ctx sdk.Context) support.GenesisAspects {
+	var aspects support.GenesisAspects
+	for _, section := range genesisSections(&aspects) {
+		store := k.newPrefixStore(ctx, section.prefix)
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			*section.entries = append(*section.entries, support.AspectStoreEntry{
+				Key:   iterator.Key(),
+				Value: iterator.Value(),
+			})
+		}
+		iterator.Close()
+	}
+	return aspects
+}
+
+// InitGenesis restores the aspect store with the exported entries.
+func (k *AspectStore) InitGenesis(ctx sdk.Context, aspects support.GenesisAspects) {
+	for _, section := range genesisSections(&aspects) {
+		store := k.newPrefixStore(ctx, section.prefix)
+		for _, entry := range *section.entries {
+			store.Set(entry.Key, entry.Value)
+		}
+	}
+}
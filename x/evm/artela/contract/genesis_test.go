@@ -0,0 +1,97 @@
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+func storeEntries(ctx sdk.Context, key storetypes.StoreKey) map[string][]byte {
+	entries := make(map[string][]byte)
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entries[string(iterator.Key())] = iterator.Value()
+	}
+	return entries
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	store, ctx, key := newTestAspectStore()
+
+	aspectID := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	account := common.HexToAddress("0x1000000000000000000000000000000000000003")
+
+	// two versions of an aspect, bound to a contract and an account
+	for _, code := range [][]byte{{0x1}, {0x2, 0x3}} {
+		version, _, err := store.BumpAspectVersion(ctx, aspectID, testGas)
+		require.NoError(t, err)
+		_, err = store.StoreAspectCode(ctx, aspectID, code, version, testGas)
+		require.NoError(t, err)
+		store.StoreAspectJP(ctx, aspectID, *version, big.NewInt(2))
+	}
+	_, err := store.StoreAspectProperty(ctx, aspectID, []types.Property{{Key: "owner", Value: []byte{0x4}}}, testGas)
+	require.NoError(t, err)
+	version := uint256.NewInt(2)
+	require.NoError(t, store.BindTxAspect(ctx, contract, aspectID, version, 1))
+	require.NoError(t, store.StoreAspectRefValue(ctx, contract, aspectID))
+	require.NoError(t, store.BindVerificationAspect(ctx, account, aspectID, version, 0, false))
+	require.NoError(t, store.StoreAspectRefValue(ctx, account, aspectID))
+
+	exported := store.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Codes, 2)
+	require.Len(t, exported.JoinPoints, 2)
+	require.NotEmpty(t, exported.Versions)
+	require.NotEmpty(t, exported.Properties)
+	require.NotEmpty(t, exported.ContractBindings)
+	require.NotEmpty(t, exported.VerifierBindings)
+	require.NotEmpty(t, exported.Refs)
+
+	// the exported aspects survive the encoding of the genesis file
+	bz, err := exported.Marshal()
+	require.NoError(t, err)
+	var imported support.GenesisAspects
+	require.NoError(t, imported.Unmarshal(bz))
+
+	newStore, newCtx, newKey := newTestAspectStore()
+	newStore.InitGenesis(newCtx, imported)
+
+	// the imported store holds the same entries, and exports the same aspects
+	require.Equal(t, storeEntries(ctx, key), storeEntries(newCtx, newKey))
+	require.Equal(t, exported, newStore.ExportGenesis(newCtx))
+
+	code, codeVersion := newStore.GetAspectCode(newCtx, aspectID, nil)
+	require.Equal(t, []byte{0x2, 0x3}, code)
+	require.Equal(t, version, codeVersion)
+	owner, _, err := newStore.GetAspectPropertyValue(newCtx, aspectID, "owner", testGas)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x4}, owner)
+
+	txAspects, err := newStore.GetTxLevelAspects(newCtx, contract)
+	require.NoError(t, err)
+	require.Len(t, txAspects, 1)
+	require.Equal(t, aspectID, txAspects[0].Id)
+	verifiers, err := newStore.GetVerificationAspects(newCtx, account)
+	require.NoError(t, err)
+	require.Len(t, verifiers, 1)
+
+	refs, err := newStore.GetAspectRefValue(newCtx, aspectID)
+	require.NoError(t, err)
+	require.Equal(t, 2, refs.Size())
+
+	expJP, err := store.GetAspectJP(ctx, aspectID, version)
+	require.NoError(t, err)
+	jp, err := newStore.GetAspectJP(newCtx, aspectID, version)
+	require.NoError(t, err)
+	require.Equal(t, expJP, jp)
+}
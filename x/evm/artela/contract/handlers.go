@@ -10,11 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 
 	"github.com/artela-network/artela-evm/vm"
 	common2 "github.com/artela-network/artela/common"
+	"github.com/artela-network/artela/common/aspect"
 	"github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
 	"github.com/artela-network/aspect-core/djpm/contract"
@@ -47,6 +49,26 @@ type HandlerContext struct {
 	gasPrice  *big.Int
 	gasTipCap *big.Int
 	gasFeeCap *big.Int
+
+	contractAddr common.Address
+}
+
+// emitEvent adds the log of an aspect system contract event to the evm state,
+// so that it can be found in the receipt of the transaction.
+func emitEvent(ctx *HandlerContext, name string, args ...interface{}) error {
+	topics, data, err := aspect.PackEvent(name, args...)
+	if err != nil {
+		ctx.logger.Error("pack aspect event failed", "event", name, "error", err)
+		return err
+	}
+
+	ctx.evmState.AddLog(&ethtypes.Log{
+		Address:     ctx.contractAddr,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.cosmosCtx.BlockHeight()),
+	})
+	return nil
 }
 
 type Handler interface {
@@ -106,7 +128,7 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 	height := ctx.cosmosCtx.BlockHeight()
 	heightU64 := uint64(height)
 
-	return runner.JoinPoint(artelasdkType.INIT_METHOD, gas, height, aspectId, &artelasdkType.InitInput{
+	ret, gas, err := runner.JoinPoint(artelasdkType.INIT_METHOD, gas, height, aspectId, &artelasdkType.InitInput{
 		Tx: &artelasdkType.WithFromTxInput{
 			Hash: txHash,
 			To:   aspectId.Bytes(),
@@ -115,6 +137,14 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		Block:    &artelasdkType.BlockInput{Number: &heightU64},
 		CallData: initData,
 	})
+	if err != nil {
+		return ret, gas, err
+	}
+
+	if err := emitEvent(ctx, "AspectDeployed", aspectId, ctx.from, newVersion.Uint64(), joinPoint); err != nil {
+		return nil, gas, err
+	}
+	return ret, gas, nil
 }
 
 func (h DeployHandler) Method() string {
@@ -138,7 +168,7 @@ func (h DeployHandler) decodeAndValidate(ctx *HandlerContext) (aspectId common.A
 
 	for i := range propertiesArr {
 		s := propertiesArr[i]
-		if types.AspectProofKey == s.Key || types.AspectAccountKey == s.Key {
+		if types.IsReservedPropertyKey(s.Key) {
 			// Block query of account and Proof
 			err = errors.New("using reserved aspect property key")
 			return
@@ -214,10 +244,15 @@ func (h UpgradeHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 	}
 
 	if len(properties) > 0 {
-		gas, err = store.StoreAspectProperty(ctx.cosmosCtx, aspectId, properties, gas)
+		if gas, err = store.StoreAspectProperty(ctx.cosmosCtx, aspectId, properties, gas); err != nil {
+			return nil, gas, err
+		}
 	}
 
-	return nil, gas, err
+	if err := emitEvent(ctx, "AspectUpgraded", aspectId, ctx.from, newVersion.Uint64(), joinPoint); err != nil {
+		return nil, gas, err
+	}
+	return nil, gas, nil
 }
 
 func (h UpgradeHandler) Method() string {
@@ -248,7 +283,7 @@ func (h UpgradeHandler) decodeAndValidate(ctx *HandlerContext, gas uint64) (aspe
 
 	for i := range propertiesArr {
 		s := propertiesArr[i]
-		if types.AspectProofKey == s.Key || types.AspectAccountKey == s.Key {
+		if types.IsReservedPropertyKey(s.Key) {
 			// Block query of account and Proof
 			err = errors.New("using reserved aspect property key")
 			return
@@ -335,6 +370,9 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, err
 	}
 
+	if err := emitEvent(ctx, "AspectBound", aspectId, account, aspectVersion.Uint64(), priority); err != nil {
+		return nil, 0, err
+	}
 	return nil, leftover, nil
 }
 
@@ -425,6 +463,9 @@ func (u UnbindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, rema
 		return nil, leftover, err
 	}
 
+	if err := emitEvent(ctx, "AspectUnbound", aspectId, account); err != nil {
+		return nil, leftover, err
+	}
 	return nil, leftover, nil
 }
 
@@ -491,6 +532,9 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 	}
 
 	err = ctx.service.aspectStore.ChangeBoundAspectVersion(ctx.cosmosCtx, account, aspectId, version, isContract, verifierAspect, txAspect)
+	if err == nil {
+		err = emitEvent(ctx, "AspectVersionChanged", aspectId, account, version)
+	}
 	remainingGas = leftover
 	return
 }
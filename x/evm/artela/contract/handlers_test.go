@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/common/aspect"
+	"github.com/artela-network/artela/x/evm/states"
+	artelasdkType "github.com/artela-network/aspect-core/types"
+)
+
+const testGas = 100000000
+
+func newTestAspectStore() (*AspectStore, sdk.Context, storetypes.StoreKey) {
+	key := storetypes.NewKVStoreKey("evm")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_evm"))
+	return NewAspectStore(key, log.NewNopLogger()), ctx, key
+}
+
+// testStateKeeper is the keeper of a chain without any account.
+type testStateKeeper struct{}
+
+func (testStateKeeper) GetAccount(sdk.Context, common.Address) *states.StateAccount { return nil }
+func (testStateKeeper) GetState(sdk.Context, common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (testStateKeeper) GetCode(sdk.Context, common.Hash) []byte { return nil }
+func (testStateKeeper) ForEachStorage(sdk.Context, common.Address, func(key, value common.Hash) bool) {
+}
+func (testStateKeeper) SetAccount(sdk.Context, common.Address, states.StateAccount) error { return nil }
+func (testStateKeeper) SetState(sdk.Context, common.Address, common.Hash, []byte)         {}
+func (testStateKeeper) SetCode(sdk.Context, []byte, []byte)                               {}
+func (testStateKeeper) DeleteAccount(sdk.Context, common.Address) error                   { return nil }
+
+func TestLifecycleEvents(t *testing.T) {
+	store, ctx, key := newTestAspectStore()
+	evmState := states.New(ctx, testStateKeeper{}, states.TxConfig{})
+	nativeContract := NewAspectNativeContract(key, nil, func() int64 { return 1 }, evmState, log.NewNopLogger())
+	nativeContract.Init()
+
+	// a verifier aspect, which can be bound to an EoA
+	aspectID := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	account := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	version, _, err := store.BumpAspectVersion(ctx, aspectID, testGas)
+	require.NoError(t, err)
+	_, err = store.StoreAspectCode(ctx, aspectID, []byte{0x1}, version, testGas)
+	require.NoError(t, err)
+	store.StoreAspectJP(ctx, aspectID, *version, big.NewInt(int64(artelasdkType.JoinPointRunType_VerifyTx)))
+
+	call := func(from common.Address, method string, args ...interface{}) error {
+		data, err := aspect.PackMethod(method, args...)
+		require.NoError(t, err)
+		_, _, err = nativeContract.ApplyMessage(ctx, &core.Message{
+			From: from,
+			To:   &aspect.SystemContractAddress,
+			Data: data,
+		}, testGas, true)
+		return err
+	}
+	requireLastEvent := func(name string, indexed []common.Address, data ...interface{}) {
+		logs := evmState.Logs()
+		require.NotEmpty(t, logs)
+		last := logs[len(logs)-1]
+
+		event, ok := aspect.GetEvent(name)
+		require.True(t, ok)
+		require.Equal(t, aspect.SystemContractAddress, last.Address)
+		require.Equal(t, event.ID, last.Topics[0])
+		require.Len(t, last.Topics, len(indexed)+1)
+		for i, addr := range indexed {
+			require.Equal(t, common.BytesToHash(addr.Bytes()), last.Topics[i+1])
+		}
+
+		values, err := event.Inputs.NonIndexed().Unpack(last.Data)
+		require.NoError(t, err)
+		require.Len(t, values, len(data))
+		for i := range data {
+			require.Equal(t, data[i], values[i])
+		}
+	}
+
+	require.NoError(t, call(account, "bind", aspectID, big.NewInt(0), account, int8(1)))
+	require.Len(t, evmState.Logs(), 1)
+	requireLastEvent("AspectBound", []common.Address{aspectID, account}, uint64(1), int8(1))
+
+	require.NoError(t, call(account, "changeVersion", aspectID, account, uint64(1)))
+	require.Len(t, evmState.Logs(), 2)
+	requireLastEvent("AspectVersionChanged", []common.Address{aspectID, account}, uint64(1))
+
+	// the failed calls emit no event
+	other := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	require.Error(t, call(other, "unbind", aspectID, account))
+	require.Error(t, call(account, "bind", common.HexToAddress("0x4"), big.NewInt(0), account, int8(1)))
+	require.Len(t, evmState.Logs(), 2)
+
+	require.NoError(t, call(account, "unbind", aspectID, account))
+	require.Len(t, evmState.Logs(), 3)
+	requireLastEvent("AspectUnbound", []common.Address{aspectID, account})
+
+	// the events are reverted with the evm state
+	snapshot := evmState.Snapshot()
+	require.NoError(t, call(account, "bind", aspectID, big.NewInt(0), account, int8(1)))
+	require.Len(t, evmState.Logs(), 4)
+	evmState.RevertToSnapshot(snapshot)
+	require.Len(t, evmState.Logs(), 3)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sort"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/artela-network/artela/x/evm/artela/types"
@@ -167,6 +169,18 @@ func (k *AspectStore) GetAspectLastVersion(ctx sdk.Context, aspectId common.Addr
 	return k.getAspectLastVersion(ctx, aspectId)
 }
 
+// PaginateAspects iterates over the deployed aspects with their latest versions.
+func (k *AspectStore) PaginateAspects(ctx sdk.Context, pageReq *query.PageRequest, onResult func(aspectId common.Address, version *uint256.Int)) (*query.PageResponse, error) {
+	versionStore := k.newPrefixStore(ctx, types.AspectCodeVersionKeyPrefix)
+	return query.Paginate(versionStore, pageReq, func(key []byte, value []byte) error {
+		if len(key) < common.AddressLength {
+			return fmt.Errorf("invalid aspect version key %x", key)
+		}
+		onResult(common.BytesToAddress(key[:common.AddressLength]), new(uint256.Int).SetBytes(value))
+		return nil
+	})
+}
+
 func (k *AspectStore) getAspectLastVersion(ctx sdk.Context, aspectId common.Address) *uint256.Int {
 	aspectVersionStore := k.newPrefixStore(ctx, types.AspectCodeVersionKeyPrefix)
 	versionKey := types.AspectIDKey(aspectId.Bytes())
@@ -262,6 +276,23 @@ func (k *AspectStore) GetAspectPropertyValue(ctx sdk.Context, aspectId common.Ad
 	return value, meter.remainingGas(), err
 }
 
+// GetAspectProperties returns all the properties of the aspect, sorted by key.
+func (k *AspectStore) GetAspectProperties(ctx sdk.Context, aspectId common.Address) []types.Property {
+	propertyStore := k.newPrefixStore(ctx, types.AspectPropertyKeyPrefix)
+	allKeys := propertyStore.Get(types.AspectPropertyKey(aspectId.Bytes(), []byte(types.AspectPropertyAllKeyPrefix)))
+	if len(allKeys) == 0 {
+		return nil
+	}
+
+	keys := strings.Split(string(allKeys), types.AspectPropertyAllKeySplit)
+	properties := make([]types.Property, 0, len(keys))
+	for _, key := range keys {
+		value := propertyStore.Get(types.AspectPropertyKey(aspectId.Bytes(), []byte(key)))
+		properties = append(properties, types.Property{Key: key, Value: value})
+	}
+	return properties
+}
+
 func (k *AspectStore) getAspectPropertyValue(ctx sdk.Context, aspectId common.Address, propertyKey string, meter *gasMeter) ([]byte, error) {
 	codeStore := k.newPrefixStore(ctx, types.AspectPropertyKeyPrefix)
 	aspectPropertyKey := types.AspectPropertyKey(
@@ -0,0 +1,171 @@
+package handle
+
+import (
+	"container/heap"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// proposalTx is a candidate tx of the block proposal.
+type proposalTx struct {
+	tx sdk.Tx
+	bz []byte
+	// sender is the account address bytes of the signer, the EVM and cosmos txs of an account share
+	// the same sender. It is empty if the signer cannot be resolved, the tx is not queued with others.
+	sender string
+	gas    uint64
+	tip    *big.Int
+	// nonce is the nonce of an EVM tx, or the sequence of the signer of a cosmos tx
+	nonce uint64
+	// index is the position of the tx in the mempool, it breaks the ties of the tips and nonces
+	index int
+}
+
+// newProposalTx creates a candidate tx, with the effective tip over the base fee.
+// The tip of an EVM tx is the effective gas tip of the ethereum tx, the tip of a
+// cosmos tx is derived from the fee paid in the evm denom over the gas limit.
+func newProposalTx(tx sdk.Tx, bz []byte, index int, baseFee *big.Int, evmDenom string) *proposalTx {
+	ptx := &proposalTx{tx: tx, bz: bz, index: index, tip: new(big.Int)}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		ptx.gas = feeTx.GetGas()
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ptx
+	}
+
+	if msg, ok := msgs[0].(*txs.MsgEthereumTx); ok {
+		ethTx := msg.AsTransaction()
+		ptx.nonce = ethTx.Nonce()
+		ptx.gas = ethTx.Gas()
+		// a fee cap below the base fee gives a negative tip, the tx is rejected by the verification
+		ptx.tip, _ = ethTx.EffectiveGasTip(baseFee)
+
+		// the sender is left empty if the signature is invalid, the tx is rejected by the verification
+		if sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(ethTx.ChainId()), ethTx); err == nil {
+			ptx.sender = string(sdk.AccAddress(sender.Bytes()))
+		}
+		return ptx
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok && ptx.gas > 0 {
+		gasPrice := new(big.Int).Quo(feeTx.GetFee().AmountOf(evmDenom).BigInt(), new(big.Int).SetUint64(ptx.gas))
+		if baseFee != nil {
+			gasPrice.Sub(gasPrice, baseFee)
+		}
+		ptx.tip = gasPrice
+	}
+
+	// the signatures are in the order of the signers, the sequence of the first signer orders the tx
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ptx
+	}
+	signers := sigTx.GetSigners()
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(signers) == 0 || len(sigs) == 0 {
+		return ptx
+	}
+	ptx.sender = string(signers[0])
+	ptx.nonce = sigs[0].Sequence
+	return ptx
+}
+
+// txsByPrice implements both the sort and the heap interface, making it useful
+// for all at once sorting as well as individually adding and removing elements.
+type txsByPrice []*proposalTx
+
+func (s txsByPrice) Len() int { return len(s) }
+func (s txsByPrice) Less(i, j int) bool {
+	// If the prices are equal, use the position in the mempool for deterministic sorting
+	cmp := s[i].tip.Cmp(s[j].tip)
+	if cmp == 0 {
+		return s[i].index < s[j].index
+	}
+	return cmp > 0
+}
+func (s txsByPrice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txsByPrice) Push(x interface{}) {
+	*s = append(*s, x.(*proposalTx))
+}
+
+func (s *txsByPrice) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*s = old[0 : n-1]
+	return x
+}
+
+// txsByPriceAndNonce represents a set of txs that can return txs in a profit-maximizing
+// sorted order, while supporting removing entire batches of txs of the same sender. The
+// EVM and cosmos txs of a sender are queued together in nonce order.
+type txsByPriceAndNonce struct {
+	txs   map[string][]*proposalTx // Per sender list of txs
+	heads txsByPrice               // Next tx for each unique sender (price heap)
+}
+
+// newTxsByPriceAndNonce creates a tx set that can retrieve price sorted txs in a nonce-honouring way.
+func newTxsByPriceAndNonce(candidates []*proposalTx) *txsByPriceAndNonce {
+	txsBySender := make(map[string][]*proposalTx)
+	heads := make(txsByPrice, 0, len(candidates))
+	for _, ptx := range candidates {
+		if ptx.sender == "" {
+			// the txs of unresolved senders are unrelated, each one is a queue of its own
+			heads = append(heads, ptx)
+			continue
+		}
+		txsBySender[ptx.sender] = append(txsBySender[ptx.sender], ptx)
+	}
+
+	for sender, list := range txsBySender {
+		// the txs of the same nonce keep their mempool order
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].nonce < list[j].nonce
+		})
+		heads = append(heads, list[0])
+		txsBySender[sender] = list[1:]
+	}
+	heap.Init(&heads)
+
+	return &txsByPriceAndNonce{
+		txs:   txsBySender,
+		heads: heads,
+	}
+}
+
+// Peek returns the next tx by price.
+func (t *txsByPriceAndNonce) Peek() *proposalTx {
+	if len(t.heads) == 0 {
+		return nil
+	}
+	return t.heads[0]
+}
+
+// Shift replaces the current best head with the next one from the same sender.
+func (t *txsByPriceAndNonce) Shift() {
+	sender := t.heads[0].sender
+	if list := t.txs[sender]; len(list) > 0 {
+		t.heads[0], t.txs[sender] = list[0], list[1:]
+		heap.Fix(&t.heads, 0)
+		return
+	}
+	heap.Pop(&t.heads)
+}
+
+// Pop removes the best tx, *not* replacing it with the next one from the same sender.
+// This should be used when a tx cannot be included, hence all subsequent txs of the
+// same sender should be discarded.
+func (t *txsByPriceAndNonce) Pop() {
+	delete(t.txs, t.heads[0].sender)
+	heap.Pop(&t.heads)
+}
@@ -2,10 +2,18 @@ package handle
 
 import (
 	"errors"
+	"math"
+	"math/big"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 )
 
 type (
@@ -17,89 +25,191 @@ type (
 		ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error)
 	}
 
-	// DefaultProposalHandler defines the default ABCI PrepareProposal and
+	// FeeKeeper defines the expected fee keeper to compute the effective tips of the txs.
+	FeeKeeper interface {
+		GetBaseFee(ctx sdk.Context) *big.Int
+	}
+
+	// EVMKeeper defines the expected evm keeper to build the txs submitted by aspects.
+	EVMKeeper interface {
+		GetParams(ctx sdk.Context) support.Params
+	}
+
+	// ArtelaProposalHandler defines the Artela ABCI PrepareProposal and
 	// ProcessProposal handlers.
 	ArtelaProposalHandler struct {
-		mempool    mempool.Mempool
-		txVerifier ProposalTxVerifier
+		mempool     mempool.Mempool
+		txVerifier  ProposalTxVerifier
+		txConfig    client.TxConfig
+		feeKeeper   FeeKeeper
+		evmKeeper   EVMKeeper
+		proposalTxs *types.ProposalTxQueue
 	}
 )
 
-func NewArtelaProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) ArtelaProposalHandler {
+func NewArtelaProposalHandler(
+	mp mempool.Mempool,
+	txVerifier ProposalTxVerifier,
+	txConfig client.TxConfig,
+	feeKeeper FeeKeeper,
+	evmKeeper EVMKeeper,
+	proposalTxs *types.ProposalTxQueue,
+) ArtelaProposalHandler {
 	return ArtelaProposalHandler{
-		mempool:    mp,
-		txVerifier: txVerifier,
+		mempool:     mp,
+		txVerifier:  txVerifier,
+		txConfig:    txConfig,
+		feeKeeper:   feeKeeper,
+		evmKeeper:   evmKeeper,
+		proposalTxs: proposalTxs,
 	}
 }
 
-// PrepareProposalHandler returns the default implementation for processing an
-// ABCI proposal. The application's mempool is enumerated and all valid
-// transactions are added to the proposal. Transactions are valid if they:
+// PrepareProposalHandler returns the Artela implementation for preparing an
+// ABCI proposal. The txs are taken from the application's mempool, or from the
+// txs requested from CometBFT if no mempool is set or if the mempool is a no-op
+// mempool, and they are added to the proposal in the following order:
 //
-// 1) Successfully encode to bytes.
-// 2) Are valid (i.e. pass runTx, AnteHandler only).
+// 1) The txs submitted by aspects during the last block, e.g. the JIT inherent txs.
+// 2) The other txs ordered by effective tip, while the EVM and cosmos txs of a sender are kept in nonce order.
 //
-// Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// A tx is added if it is valid (i.e. pass runTx, AnteHandler only), and it fits
+// into both RequestPrepareProposal.MaxTxBytes and the block gas limit, otherwise
+// it is skipped together with the later txs of the same sender.
 //
 // Note:
 //
-// - Step (2) is identical to the validation step performed in
-// DefaultProcessProposal. It is very important that the same validation logic
-// is used in both steps, and applications must ensure that this is the case in
-// non-default handlers.
-//
-// - If no mempool is set or if the mempool is a no-op mempool, the transactions
-// requested from Tendermint will simply be returned, which, by default, are in
-// FIFO order.
+//   - The validation step is identical to the one performed in ProcessProposal. It
+//     is very important that the same validation logic is used in both steps.
 func (h ArtelaProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
-		// If the mempool is nil or NoOp we simply return the transactions
-		// requested from CometBFT, which, by default, should be in FIFO order.
-		_, isNoOp := h.mempool.(mempool.NoOpMempool)
-		if h.mempool == nil || isNoOp {
-			return abci.ResponsePrepareProposal{Txs: req.Txs}
-		}
-
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
+			totalGas     uint64
 		)
 
-		iterator := h.mempool.Select(ctx, req.Txs)
+		maxGas := artela.BlockGasLimit(ctx)
+		if maxGas == 0 {
+			maxGas = math.MaxUint64
+		}
 
-		for iterator != nil {
-			memTx := iterator.Tx()
+		// include checks the capacity left in the proposal, and adds the tx if it is valid
+		include := func(ptx *proposalTx) bool {
+			txSize := int64(len(ptx.bz))
+			if totalTxBytes+txSize > req.MaxTxBytes || totalGas+ptx.gas > maxGas || totalGas+ptx.gas < totalGas {
+				return false
+			}
 
 			// NOTE: Since transaction verification was already executed in CheckTx,
 			// which calls mempool.Insert, in theory everything in the pool should be
 			// valid. But some mempool implementations may insert invalid txs, so we
 			// check again.
-			bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+			bz, err := h.txVerifier.PrepareProposalVerifyTx(ptx.tx)
 			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					panic(err)
-				}
-			} else {
-				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
-					// We've reached capacity per req.MaxTxBytes so we cannot select any
-					// more transactions.
-					break
-				}
+				h.removeFromMempool(ptx.tx)
+				return false
+			}
+
+			selectedTxs = append(selectedTxs, bz)
+			totalTxBytes += txSize
+			totalGas += ptx.gas
+			return true
+		}
+
+		baseFee := h.feeKeeper.GetBaseFee(ctx)
+		evmDenom := h.evmKeeper.GetParams(ctx).EvmDenom
+
+		for _, ptx := range h.inherentTxs(ctx, baseFee, evmDenom) {
+			if !include(ptx) {
+				ctx.Logger().Debug("proposal tx submitted by aspect is not included", "sender", ptx.sender)
 			}
+		}
 
-			iterator = iterator.Next()
+		ordered := newTxsByPriceAndNonce(h.candidateTxs(ctx, req, baseFee, evmDenom))
+		for ptx := ordered.Peek(); ptx != nil; ptx = ordered.Peek() {
+			if include(ptx) {
+				ordered.Shift()
+			} else {
+				ordered.Pop()
+			}
 		}
 
 		return abci.ResponsePrepareProposal{Txs: selectedTxs}
 	}
 }
 
-// ProcessProposalHandler returns the default implementation for processing an
+// candidateTxs returns the txs of the mempool, or the txs requested from CometBFT
+// if the mempool is nil or NoOp, the txs which cannot be encoded or decoded are dropped.
+func (h ArtelaProposalHandler) candidateTxs(ctx sdk.Context, req abci.RequestPrepareProposal, baseFee *big.Int, evmDenom string) []*proposalTx {
+	var candidates []*proposalTx
+
+	_, isNoOp := h.mempool.(mempool.NoOpMempool)
+	if h.mempool == nil || isNoOp {
+		decoder := h.txConfig.TxDecoder()
+		for i, bz := range req.Txs {
+			tx, err := decoder(bz)
+			if err != nil {
+				continue
+			}
+			candidates = append(candidates, newProposalTx(tx, bz, i, baseFee, evmDenom))
+		}
+		return candidates
+	}
+
+	encoder := h.txConfig.TxEncoder()
+	for iterator := h.mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+		memTx := iterator.Tx()
+		bz, err := encoder(memTx)
+		if err != nil {
+			h.removeFromMempool(memTx)
+			continue
+		}
+		candidates = append(candidates, newProposalTx(memTx, bz, len(candidates), baseFee, evmDenom))
+	}
+	return candidates
+}
+
+// inherentTxs drains the txs submitted by aspects, and builds the cosmos txs of them.
+func (h ArtelaProposalHandler) inherentTxs(ctx sdk.Context, baseFee *big.Int, evmDenom string) []*proposalTx {
+	if h.proposalTxs == nil {
+		return nil
+	}
+
+	var inherents []*proposalTx
+	encoder := h.txConfig.TxEncoder()
+	for i, baseLayerTx := range h.proposalTxs.Drain() {
+		msg := &txs.MsgEthereumTx{}
+		if err := msg.UnmarshalBinary(baseLayerTx.Bytes()); err != nil {
+			ctx.Logger().Debug("failed to decode proposal tx submitted by aspect", "error", err)
+			continue
+		}
+		tx, err := msg.BuildTx(h.txConfig.NewTxBuilder(), evmDenom)
+		if err != nil {
+			ctx.Logger().Debug("failed to build proposal tx submitted by aspect", "error", err)
+			continue
+		}
+		bz, err := encoder(tx)
+		if err != nil {
+			ctx.Logger().Debug("failed to encode proposal tx submitted by aspect", "error", err)
+			continue
+		}
+		inherents = append(inherents, newProposalTx(tx, bz, i, baseFee, evmDenom))
+	}
+	return inherents
+}
+
+// removeFromMempool removes an invalid tx from the mempool, if any.
+func (h ArtelaProposalHandler) removeFromMempool(tx sdk.Tx) {
+	if h.mempool == nil {
+		return
+	}
+	if err := h.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		panic(err)
+	}
+}
+
+// ProcessProposalHandler returns the Artela implementation for processing an
 // ABCI proposal. Every transaction in the proposal must pass 2 conditions:
 //
 // 1. The transaction bytes must decode to a valid transaction.
@@ -107,17 +217,9 @@ func (h ArtelaProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandl
 //
 // If any transaction fails to pass either condition, the proposal is rejected.
 // Note that step (2) is identical to the validation step performed in
-// DefaultPrepareProposal. It is very important that the same validation logic
-// is used in both steps, and applications must ensure that this is the case in
-// non-default handlers.
+// PrepareProposalHandler, which verifies the txs whether they are taken from the
+// mempool or requested from CometBFT, so the proposals are verified with any mempool.
 func (h ArtelaProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
-	// If the mempool is nil or NoOp we simply return ACCEPT,
-	// because PrepareProposal may have included txs that could fail verification.
-	_, isNoOp := h.mempool.(mempool.NoOpMempool)
-	if h.mempool == nil || isNoOp {
-		return NoOpProcessProposal()
-	}
-
 	return func(_ sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
 		for _, txBytes := range req.Txs {
 			_, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
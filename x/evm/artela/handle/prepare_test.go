@@ -0,0 +1,258 @@
+package handle
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+const testDenom = "aart"
+
+var testChainID = big.NewInt(11822)
+
+// testTxVerifier accepts every tx but the rejected ones.
+type testTxVerifier struct {
+	txConfig client.TxConfig
+	rejected [][]byte
+}
+
+func (v *testTxVerifier) isRejected(bz []byte) bool {
+	for _, rejected := range v.rejected {
+		if bytes.Equal(rejected, bz) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	bz, err := v.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	if v.isRejected(bz) {
+		return nil, errors.New("rejected")
+	}
+	return bz, nil
+}
+
+func (v *testTxVerifier) ProcessProposalVerifyTx(bz []byte) (sdk.Tx, error) {
+	if v.isRejected(bz) {
+		return nil, errors.New("rejected")
+	}
+	return v.txConfig.TxDecoder()(bz)
+}
+
+type testFeeKeeper struct{}
+
+func (testFeeKeeper) GetBaseFee(sdk.Context) *big.Int { return big.NewInt(1) }
+
+type testEVMKeeper struct{}
+
+func (testEVMKeeper) GetParams(sdk.Context) support.Params {
+	return support.Params{EvmDenom: testDenom}
+}
+
+func newTestTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	txs.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+// newTestProposalHandler returns a proposal handler over the txs requested from CometBFT,
+// and the context of a block with the given gas limit.
+func newTestProposalHandler(maxGas int64) (ArtelaProposalHandler, *testTxVerifier, sdk.Context) {
+	txConfig := newTestTxConfig()
+	verifier := &testTxVerifier{txConfig: txConfig}
+	handler := NewArtelaProposalHandler(mempool.NoOpMempool{}, verifier, txConfig, testFeeKeeper{}, testEVMKeeper{}, nil)
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas}})
+	return handler, verifier, ctx
+}
+
+// encodeEthTx encodes a dynamic fee tx with the given tip over the base fee of 1.
+func encodeEthTx(t *testing.T, txConfig client.TxConfig, key *ecdsa.PrivateKey, nonce, gas uint64, tip int64) []byte {
+	to := common.HexToAddress("0x1")
+	inner := &ethtypes.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     nonce,
+		To:        &to,
+		Gas:       gas,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(tip + 1),
+	}
+
+	var tx *ethtypes.Transaction
+	if key == nil {
+		// the sender of a tx without signature cannot be resolved
+		tx = ethtypes.NewTx(inner)
+	} else {
+		var err error
+		tx, err = ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(testChainID), inner)
+		require.NoError(t, err)
+	}
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	sdkTx, err := msg.BuildTx(txConfig.NewTxBuilder(), testDenom)
+	require.NoError(t, err)
+	bz, err := txConfig.TxEncoder()(sdkTx)
+	require.NoError(t, err)
+	return bz
+}
+
+// encodeCosmosTx encodes a bank tx of the account of key, paying the given gas price.
+func encodeCosmosTx(t *testing.T, txConfig client.TxConfig, key *ecdsa.PrivateKey, sequence, gas uint64, gasPrice int64) []byte {
+	pubKey := &secp256k1.PubKey{Key: crypto.CompressPubkey(&key.PublicKey)}
+	from := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1)))))
+	builder.SetGasLimit(gas)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(testDenom, sdk.NewIntFromUint64(gas).MulRaw(gasPrice))))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func newTestKeys(t *testing.T, n int) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	return keys
+}
+
+// prepare returns the positions in the request of the txs included in the proposal.
+func prepare(t *testing.T, handler ArtelaProposalHandler, ctx sdk.Context, reqTxs [][]byte) []int {
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{Txs: reqTxs, MaxTxBytes: 1 << 20})
+
+	var included []int
+	for _, bz := range res.Txs {
+		index := -1
+		for i, reqTx := range reqTxs {
+			if bytes.Equal(reqTx, bz) {
+				index = i
+			}
+		}
+		require.NotEqual(t, -1, index)
+		included = append(included, index)
+	}
+	return included
+}
+
+func TestPrepareProposalTipOrder(t *testing.T) {
+	handler, _, ctx := newTestProposalHandler(-1)
+	keys := newTestKeys(t, 4)
+
+	// the txs are ordered by the effective tip, the ties keep the request order
+	reqTxs := [][]byte{
+		encodeEthTx(t, handler.txConfig, keys[0], 0, 21000, 1),
+		encodeEthTx(t, handler.txConfig, keys[1], 0, 21000, 3),
+		encodeCosmosTx(t, handler.txConfig, keys[2], 0, 100000, 3),
+		encodeEthTx(t, handler.txConfig, keys[3], 0, 21000, 2),
+	}
+	require.Equal(t, []int{1, 2, 3, 0}, prepare(t, handler, ctx, reqTxs))
+}
+
+func TestPrepareProposalNonceOrder(t *testing.T) {
+	handler, _, ctx := newTestProposalHandler(-1)
+	keys := newTestKeys(t, 2)
+
+	// the EVM and cosmos txs of an account are queued together in nonce order,
+	// a tx paying more is not included before the lower nonces of its sender
+	reqTxs := [][]byte{
+		encodeEthTx(t, handler.txConfig, keys[0], 1, 21000, 5),
+		encodeCosmosTx(t, handler.txConfig, keys[0], 2, 100000, 9),
+		encodeEthTx(t, handler.txConfig, keys[1], 0, 21000, 3),
+		encodeEthTx(t, handler.txConfig, keys[0], 0, 21000, 1),
+	}
+	require.Equal(t, []int{2, 3, 0, 1}, prepare(t, handler, ctx, reqTxs))
+}
+
+func TestPrepareProposalGasLimit(t *testing.T) {
+	handler, verifier, ctx := newTestProposalHandler(70000)
+	keys := newTestKeys(t, 3)
+
+	// the txs exceeding the gas left are skipped with the later txs of their sender,
+	// the smaller txs still fill the block
+	reqTxs := [][]byte{
+		encodeEthTx(t, handler.txConfig, keys[0], 0, 21000, 5),
+		encodeEthTx(t, handler.txConfig, keys[1], 0, 60000, 4),
+		encodeEthTx(t, handler.txConfig, keys[1], 1, 21000, 4),
+		encodeEthTx(t, handler.txConfig, keys[2], 0, 21000, 3),
+		encodeEthTx(t, handler.txConfig, keys[2], 1, 21000, 3),
+		encodeEthTx(t, handler.txConfig, keys[0], 1, 21000, 1),
+	}
+	require.Equal(t, []int{0, 3, 4}, prepare(t, handler, ctx, reqTxs))
+
+	// the invalid txs are skipped with the later txs of their sender too
+	verifier.rejected = [][]byte{reqTxs[3]}
+	require.Equal(t, []int{0, 5}, prepare(t, handler, ctx, reqTxs))
+}
+
+func TestPrepareProposalUnresolvedSenders(t *testing.T) {
+	handler, verifier, ctx := newTestProposalHandler(-1)
+
+	// the txs of unresolved senders are not queued together, skipping one keeps the others
+	reqTxs := [][]byte{
+		encodeEthTx(t, handler.txConfig, nil, 0, 21000, 2),
+		encodeEthTx(t, handler.txConfig, nil, 1, 21000, 1),
+	}
+	verifier.rejected = [][]byte{reqTxs[0]}
+	require.Equal(t, []int{1}, prepare(t, handler, ctx, reqTxs))
+}
+
+func TestProcessProposal(t *testing.T) {
+	handler, verifier, _ := newTestProposalHandler(-1)
+	keys := newTestKeys(t, 1)
+	reqTxs := [][]byte{
+		encodeEthTx(t, handler.txConfig, keys[0], 0, 21000, 1),
+		encodeEthTx(t, handler.txConfig, keys[0], 1, 21000, 1),
+	}
+
+	process := handler.ProcessProposalHandler()
+	res := process(sdk.Context{}, abci.RequestProcessProposal{Txs: reqTxs})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	// the txs are verified without the app-side mempool as well
+	verifier.rejected = [][]byte{reqTxs[1]}
+	res = process(sdk.Context{}, abci.RequestProcessProposal{Txs: reqTxs})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	res = process(sdk.Context{}, abci.RequestProcessProposal{Txs: [][]byte{{0x1}}})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+}
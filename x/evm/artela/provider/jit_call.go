@@ -1,28 +1,87 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
+	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 
+	"github.com/artela-network/artela-evm/vm"
+	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/aspect-core/integration"
 )
 
 var _ integration.AspectProtocol = (*AspectProtocolProvider)(nil)
 
+// EVMKeeper defines the expected EVM keeper used by AspectProtocolProvider
+type EVMKeeper interface {
+	states.Keeper
+
+	NewEVM(ctx cosmos.Context, msg *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
+	EVMConfigFromCtx(ctx cosmos.Context) (*states.EVMConfig, error)
+	GetNonce(ctx cosmos.Context, addr common.Address) uint64
+	GetBalance(ctx cosmos.Context, addr common.Address) *big.Int
+	GetBaseFee(ctx cosmos.Context, ethCfg *ethparams.ChainConfig) *big.Int
+	ChainID() *big.Int
+	EstimateGasWithConfig(ctx cosmos.Context, args txs.TransactionArgs, gasCap uint64, cfg *states.EVMConfig, blockCtx *types.EthBlockContext) (uint64, error)
+}
+
 type AspectProtocolProvider struct {
-	getEthTxContext func() *types.EthTxContext
+	aspectCtx *types.AspectRuntimeContext
+	evmKeeper EVMKeeper
+
+	// proposalTxs is nil if the txs cannot be submitted to the proposal, e.g. in check tx or queries
+	proposalTxs *types.ProposalTxQueue
 }
 
-func NewAspectProtocolProvider(getEthTxContext func() *types.EthTxContext) *AspectProtocolProvider {
+func NewAspectProtocolProvider(aspectCtx *types.AspectRuntimeContext, evmKeeper EVMKeeper) *AspectProtocolProvider {
 	return &AspectProtocolProvider{
-		getEthTxContext: getEthTxContext,
+		aspectCtx: aspectCtx,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// WithProposalTxQueue enables the aspects to submit txs to the block proposal through the queue.
+func (a *AspectProtocolProvider) WithProposalTxQueue(proposalTxs *types.ProposalTxQueue) *AspectProtocolProvider {
+	a.proposalTxs = proposalTxs
+	return a
+}
+
+func (a *AspectProtocolProvider) getEthTxContext() *types.EthTxContext {
+	return a.aspectCtx.EthTxContext()
+}
+
+// stateDB returns the state db of the current transaction, nil if the transaction is not started yet.
+func (a *AspectProtocolProvider) stateDB() vm.StateDB {
+	txContext := a.getEthTxContext()
+	if txContext == nil {
+		return nil
 	}
+	return txContext.VmStateDB()
 }
 
+func (a *AspectProtocolProvider) evmConfig() (*states.EVMConfig, error) {
+	if txContext := a.getEthTxContext(); txContext != nil && txContext.EvmCfg() != nil {
+		return txContext.EvmCfg(), nil
+	}
+	return a.evmKeeper.EVMConfigFromCtx(a.aspectCtx.CosmosContext())
+}
+
+// ChainId returns the chain id of the evm config, or the one of the keeper if the config is not available.
 func (a *AspectProtocolProvider) ChainId() *big.Int {
-	return a.getEthTxContext().EvmCfg().ChainConfig.ChainID
+	if cfg, err := a.evmConfig(); err == nil && cfg.ChainConfig != nil && cfg.ChainConfig.ChainID != nil {
+		return cfg.ChainConfig.ChainID
+	}
+	return a.evmKeeper.ChainID()
 }
 
 func (a *AspectProtocolProvider) VMFromSnapshotState() (integration.VM, error) {
@@ -34,47 +93,250 @@ func (a *AspectProtocolProvider) VMFromSnapshotState() (integration.VM, error) {
 	return evm, nil
 }
 
-func (a AspectProtocolProvider) VMFromCanonicalState() (integration.VM, error) {
-	// TODO implement me
-	panic("implement me")
+// VMFromCanonicalState creates a new EVM on top of the state of the cosmos context,
+// the uncommitted changes of the current transaction are not visible to it.
+func (a *AspectProtocolProvider) VMFromCanonicalState() (integration.VM, error) {
+	ctx := a.aspectCtx.CosmosContext()
+	cfg, err := a.evmConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &core.Message{GasPrice: big.NewInt(0), Value: big.NewInt(0)}
+	if txContext := a.getEthTxContext(); txContext != nil && txContext.Message() != nil {
+		msg = txContext.Message()
+	}
+
+	stateDB := states.New(ctx, a.evmKeeper, states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	return a.evmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB), nil
+}
+
+// ConvertProtocolTx converts the tx data into an unsigned ethereum transaction.
+func (a *AspectProtocolProvider) ConvertProtocolTx(txData integration.TxData) (integration.BaseLayerTx, error) {
+	if txData == nil {
+		return nil, errors.New("tx data is nil")
+	}
+
+	to := recipient(txData)
+	var data ethereum.TxData
+	switch txData.TxType() {
+	case ethereum.LegacyTxType:
+		data = &ethereum.LegacyTx{
+			Nonce:    txData.Nonce(),
+			GasPrice: txData.GasPrice(),
+			Gas:      txData.Gas(),
+			To:       to,
+			Value:    txData.Value(),
+			Data:     txData.Data(),
+		}
+	case ethereum.AccessListTxType:
+		data = &ethereum.AccessListTx{
+			ChainID:  a.ChainId(),
+			Nonce:    txData.Nonce(),
+			GasPrice: txData.GasPrice(),
+			Gas:      txData.Gas(),
+			To:       to,
+			Value:    txData.Value(),
+			Data:     txData.Data(),
+		}
+	case ethereum.DynamicFeeTxType:
+		data = &ethereum.DynamicFeeTx{
+			ChainID:   a.ChainId(),
+			Nonce:     txData.Nonce(),
+			GasTipCap: txData.GasTipCap(),
+			GasFeeCap: txData.GasFeeCap(),
+			Gas:       txData.Gas(),
+			To:        to,
+			Value:     txData.Value(),
+			Data:      txData.Data(),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", txData.TxType())
+	}
+
+	return newBaseLayerTx(ethereum.NewTx(data), txData.From())
+}
+
+// EstimateGas estimates the gas needed by the tx data on top of the state of the cosmos context,
+// the estimation runs on a branch of the context so that the current execution is not affected.
+func (a *AspectProtocolProvider) EstimateGas(txData integration.TxData) (uint64, error) {
+	if txData == nil {
+		return 0, errors.New("tx data is nil")
+	}
+
+	cfg, err := a.evmConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := a.aspectCtx.CosmosContext()
+	from := txData.From()
+	input := hexutil.Bytes(txData.Data())
+	args := txs.TransactionArgs{
+		From:  &from,
+		To:    recipient(txData),
+		Input: &input,
+	}
+	if gas := txData.Gas(); gas > 0 {
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if value := txData.Value(); value != nil {
+		args.Value = (*hexutil.Big)(value)
+	}
+	if txData.TxType() == ethereum.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(txData.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(txData.GasTipCap())
+	} else if gasPrice := txData.GasPrice(); gasPrice != nil {
+		args.GasPrice = (*hexutil.Big)(gasPrice)
+	}
+
+	gasCap := artela.BlockGasLimit(ctx)
+	if gasCap < txData.Gas() {
+		gasCap = txData.Gas()
+	}
+
+	branch, _ := ctx.CacheContext()
+	return a.evmKeeper.EstimateGasWithConfig(branch, args, gasCap, cfg, a.aspectCtx.EthBlockContext())
+}
+
+// GasPrice returns the base fee of the current block.
+func (a *AspectProtocolProvider) GasPrice() (*big.Int, error) {
+	cfg, err := a.evmConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.BaseFee != nil {
+		return new(big.Int).Set(cfg.BaseFee), nil
+	}
+
+	baseFee := a.evmKeeper.GetBaseFee(a.aspectCtx.CosmosContext(), cfg.ChainConfig)
+	if baseFee == nil {
+		// london hardfork not enabled
+		return big.NewInt(0), nil
+	}
+	return baseFee, nil
 }
 
-func (a AspectProtocolProvider) ConvertProtocolTx(txData integration.TxData) (integration.BaseLayerTx, error) {
-	// TODO implement me
-	panic("implement me")
+// LastBlockHeader returns the header of the block being processed.
+func (a *AspectProtocolProvider) LastBlockHeader() (integration.BlockHeader, error) {
+	blockCtx := a.aspectCtx.EthBlockContext()
+	if blockCtx == nil || blockCtx.BlockHeader() == nil {
+		return nil, errors.New("block context is not initialized")
+	}
+	return &blockHeader{blockCtx.BlockHeader()}, nil
 }
 
-func (a AspectProtocolProvider) EstimateGas(txData integration.TxData) (uint64, error) {
-	// TODO implement me
-	panic("implement me")
+func (a *AspectProtocolProvider) NonceOf(address common.Address) (uint64, error) {
+	if stateDB := a.stateDB(); stateDB != nil {
+		return stateDB.GetNonce(address), nil
+	}
+	return a.evmKeeper.GetNonce(a.aspectCtx.CosmosContext(), address), nil
 }
 
-func (a AspectProtocolProvider) GasPrice() (*big.Int, error) {
-	// TODO implement me
-	panic("implement me")
+// SubmitTxToCurrentProposal queues the tx to the proposal tx queue. The block proposal cannot be
+// modified once the transactions are being executed, so the tx is not added to the current block
+// but placed in the next proposal, if it is prepared by this node. types.ErrNoOpenProposal is
+// returned if no block is being executed, e.g. in check tx, queries or while a proposal is being
+// prepared or processed.
+func (a *AspectProtocolProvider) SubmitTxToCurrentProposal(tx integration.BaseLayerTx) error {
+	if a.proposalTxs == nil {
+		return types.ErrNoOpenProposal
+	}
+	return a.proposalTxs.Submit(tx)
 }
 
-func (a AspectProtocolProvider) LastBlockHeader() (integration.BlockHeader, error) {
-	// TODO implement me
-	panic("implement me")
+// InitSystemContract deploys the system contract at the given address with the initial storage.
+// The code is only set for solidity contracts, native contracts only get their storage initialized.
+func (a *AspectProtocolProvider) InitSystemContract(addr common.Address, code []byte, storage map[common.Hash][]byte, contractType integration.SystemContractType) error {
+	stateDB := a.stateDB()
+	var commit func() error
+	if stateDB == nil {
+		ctx := a.aspectCtx.CosmosContext()
+		db := states.New(ctx, a.evmKeeper, states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		stateDB, commit = db, db.Commit
+	}
+
+	if !stateDB.Exist(addr) {
+		stateDB.CreateAccount(addr)
+	}
+	if contractType == integration.Solidity && len(code) > 0 {
+		stateDB.SetCode(addr, code)
+	}
+	for key, value := range storage {
+		stateDB.SetState(addr, key, common.BytesToHash(value))
+	}
+
+	if commit != nil {
+		return commit()
+	}
+	return nil
 }
 
-func (a AspectProtocolProvider) NonceOf(address common.Address) (uint64, error) {
-	// TODO implement me
-	panic("implement me")
+func (a *AspectProtocolProvider) BalanceOf(address common.Address) *big.Int {
+	if stateDB := a.stateDB(); stateDB != nil {
+		return stateDB.GetBalance(address)
+	}
+	return a.evmKeeper.GetBalance(a.aspectCtx.CosmosContext(), address)
 }
 
-func (a AspectProtocolProvider) SubmitTxToCurrentProposal(tx integration.BaseLayerTx) error {
-	// TODO implement me
-	panic("implement me")
+// recipient returns the recipient of the tx data, nil if the tx data creates a contract.
+func recipient(txData integration.TxData) *common.Address {
+	to := txData.To()
+	if to == (common.Address{}) {
+		return nil
+	}
+	return &to
+}
+
+// baseLayerTx wraps an unsigned ethereum transaction as integration.BaseLayerTx
+type baseLayerTx struct {
+	tx   *ethereum.Transaction
+	from common.Address
+	// raw is the binary encoding of tx, encoded upfront since Bytes cannot fail
+	raw []byte
+}
+
+func newBaseLayerTx(tx *ethereum.Transaction, from common.Address) (*baseLayerTx, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+	return &baseLayerTx{tx: tx, from: from, raw: raw}, nil
 }
 
-func (a AspectProtocolProvider) InitSystemContract(addr common.Address, code []byte, storage map[common.Hash][]byte, contractType integration.SystemContractType) error {
-	// TODO implement me
-	panic("implement me")
+func (t *baseLayerTx) Bytes() []byte {
+	return t.raw
 }
 
-func (a AspectProtocolProvider) BalanceOf(address common.Address) *big.Int {
-	// TODO implement me
-	panic("implement me")
+func (t *baseLayerTx) Hash() []byte {
+	return t.tx.Hash().Bytes()
 }
+
+func (t *baseLayerTx) Sender() []byte {
+	return t.from.Bytes()
+}
+
+func (t *baseLayerTx) Recipient() []byte {
+	if t.tx.To() == nil {
+		return nil
+	}
+	return t.tx.To().Bytes()
+}
+
+// blockHeader wraps an ethereum header as integration.BlockHeader
+type blockHeader struct {
+	header *ethereum.Header
+}
+
+func (h *blockHeader) ParentHash() common.Hash  { return h.header.ParentHash }
+func (h *blockHeader) Coinbase() common.Address { return h.header.Coinbase }
+func (h *blockHeader) Root() common.Hash        { return h.header.Root }
+func (h *blockHeader) TxHash() common.Hash      { return h.header.TxHash }
+func (h *blockHeader) ReceiptHash() common.Hash { return h.header.ReceiptHash }
+func (h *blockHeader) Number() *big.Int         { return h.header.Number }
+func (h *blockHeader) GasLimit() uint64         { return h.header.GasLimit }
+func (h *blockHeader) GasUsed() uint64          { return h.header.GasUsed }
+func (h *blockHeader) Time() uint64             { return h.header.Time }
+func (h *blockHeader) Extra() []byte            { return h.header.Extra }
+func (h *blockHeader) MixDigest() common.Hash   { return h.header.MixDigest }
+func (h *blockHeader) BaseFee() *big.Int        { return h.header.BaseFee }
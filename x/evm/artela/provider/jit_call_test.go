@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+var (
+	testChainID = big.NewInt(11822)
+	testFrom    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testTo      = common.HexToAddress("0x1000000000000000000000000000000000000002")
+)
+
+// testTxData is the tx data submitted by an aspect.
+type testTxData struct {
+	txType    byte
+	to        common.Address
+	gas       uint64
+	gasPrice  *big.Int
+	gasTipCap *big.Int
+	gasFeeCap *big.Int
+}
+
+func (d testTxData) TxType() byte                  { return d.txType }
+func (d testTxData) From() common.Address          { return testFrom }
+func (d testTxData) To() common.Address            { return d.to }
+func (d testTxData) Data() []byte                  { return []byte{0x1} }
+func (d testTxData) Gas() uint64                   { return d.gas }
+func (d testTxData) GasPrice() *big.Int            { return d.gasPrice }
+func (d testTxData) GasTipCap() *big.Int           { return d.gasTipCap }
+func (d testTxData) GasFeeCap() *big.Int           { return d.gasFeeCap }
+func (d testTxData) Value() *big.Int               { return big.NewInt(7) }
+func (d testTxData) Nonce() uint64                 { return 3 }
+func (d testTxData) Extra() map[string]interface{} { return nil }
+
+// testEVMKeeper serves the state of the accounts, and records the estimated txs.
+type testEVMKeeper struct {
+	EVMKeeper
+	baseFee *big.Int
+
+	estimated []txs.TransactionArgs
+	gasCaps   []uint64
+}
+
+func (k *testEVMKeeper) EVMConfigFromCtx(cosmos.Context) (*states.EVMConfig, error) {
+	return &states.EVMConfig{ChainConfig: &ethparams.ChainConfig{ChainID: testChainID}}, nil
+}
+
+func (k *testEVMKeeper) GetNonce(cosmos.Context, common.Address) uint64             { return 5 }
+func (k *testEVMKeeper) GetBalance(cosmos.Context, common.Address) *big.Int         { return big.NewInt(100) }
+func (k *testEVMKeeper) GetBaseFee(cosmos.Context, *ethparams.ChainConfig) *big.Int { return k.baseFee }
+func (k *testEVMKeeper) ChainID() *big.Int                                          { return testChainID }
+
+func (k *testEVMKeeper) EstimateGasWithConfig(_ cosmos.Context, args txs.TransactionArgs, gasCap uint64,
+	_ *states.EVMConfig, _ *types.EthBlockContext,
+) (uint64, error) {
+	k.estimated = append(k.estimated, args)
+	k.gasCaps = append(k.gasCaps, gasCap)
+	return 21000, nil
+}
+
+func newTestProvider(keeper EVMKeeper) *AspectProtocolProvider {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	aspectCtx := types.NewAspectRuntimeContext()
+	aspectCtx.WithCosmosContext(ctx.WithBlockGasMeter(storetypes.NewGasMeter(30000000)))
+	return NewAspectProtocolProvider(aspectCtx, keeper)
+}
+
+func TestConvertProtocolTx(t *testing.T) {
+	provider := newTestProvider(&testEVMKeeper{})
+
+	testCases := []struct {
+		name    string
+		txData  testTxData
+		expType uint8
+		expTo   *common.Address
+		expErr  bool
+	}{
+		{"legacy tx", testTxData{txType: ethereum.LegacyTxType, to: testTo, gasPrice: big.NewInt(1)}, ethereum.LegacyTxType, &testTo, false},
+		{"access list tx", testTxData{txType: ethereum.AccessListTxType, to: testTo, gasPrice: big.NewInt(1)}, ethereum.AccessListTxType, &testTo, false},
+		{"dynamic fee tx", testTxData{txType: ethereum.DynamicFeeTxType, to: testTo, gasTipCap: big.NewInt(1), gasFeeCap: big.NewInt(2)}, ethereum.DynamicFeeTxType, &testTo, false},
+		{"creation tx", testTxData{txType: ethereum.LegacyTxType, gasPrice: big.NewInt(1)}, ethereum.LegacyTxType, nil, false},
+		{"unsupported tx type", testTxData{txType: ethereum.BlobTxType}, 0, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			baseTx, err := provider.ConvertProtocolTx(tc.txData)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testFrom.Bytes(), baseTx.Sender())
+
+			// the bytes are the binary encoding of the unsigned tx
+			var tx ethereum.Transaction
+			require.NoError(t, tx.UnmarshalBinary(baseTx.Bytes()))
+			require.Equal(t, tx.Hash().Bytes(), baseTx.Hash())
+			require.Equal(t, tc.expType, tx.Type())
+			require.Equal(t, tc.expTo, tx.To())
+			require.Equal(t, uint64(3), tx.Nonce())
+			require.Equal(t, big.NewInt(7), tx.Value())
+			if tc.expTo == nil {
+				require.Nil(t, baseTx.Recipient())
+			} else {
+				require.Equal(t, tc.expTo.Bytes(), baseTx.Recipient())
+			}
+			if tx.Type() != ethereum.LegacyTxType {
+				require.Equal(t, testChainID, tx.ChainId())
+			}
+		})
+	}
+
+	_, err := provider.ConvertProtocolTx(nil)
+	require.Error(t, err)
+}
+
+func TestEstimateGas(t *testing.T) {
+	keeper := &testEVMKeeper{}
+	provider := newTestProvider(keeper)
+
+	gas, err := provider.EstimateGas(testTxData{txType: ethereum.LegacyTxType, to: testTo, gas: 50000, gasPrice: big.NewInt(1)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), gas)
+
+	// a creation tx with dynamic fee, asking for more gas than the block gas limit
+	_, err = provider.EstimateGas(testTxData{txType: ethereum.DynamicFeeTxType, gas: 40000000, gasTipCap: big.NewInt(1), gasFeeCap: big.NewInt(2)})
+	require.NoError(t, err)
+
+	require.Len(t, keeper.estimated, 2)
+	legacy, dynamic := keeper.estimated[0], keeper.estimated[1]
+	require.Equal(t, testFrom, *legacy.From)
+	require.Equal(t, testTo, *legacy.To)
+	require.Equal(t, uint64(50000), uint64(*legacy.Gas))
+	require.Equal(t, big.NewInt(1), legacy.GasPrice.ToInt())
+	require.Nil(t, legacy.MaxFeePerGas)
+
+	require.Nil(t, dynamic.To)
+	require.Nil(t, dynamic.GasPrice)
+	require.Equal(t, big.NewInt(2), dynamic.MaxFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(1), dynamic.MaxPriorityFeePerGas.ToInt())
+
+	// the gas cap is the block gas limit, unless the tx asks for more
+	require.Equal(t, []uint64{30000000, 40000000}, keeper.gasCaps)
+
+	_, err = provider.EstimateGas(nil)
+	require.Error(t, err)
+}
+
+func TestProviderState(t *testing.T) {
+	keeper := &testEVMKeeper{}
+	provider := newTestProvider(keeper)
+
+	// the state is read from the keeper without tx context
+	require.Equal(t, testChainID, provider.ChainId())
+	nonce, err := provider.NonceOf(testFrom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), nonce)
+	require.Equal(t, big.NewInt(100), provider.BalanceOf(testFrom))
+
+	// the gas price is the base fee, or zero before london
+	gasPrice, err := provider.GasPrice()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), gasPrice)
+	keeper.baseFee = big.NewInt(10)
+	gasPrice, err = provider.GasPrice()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), gasPrice)
+
+	// the header is only available once the block context is set
+	_, err = provider.LastBlockHeader()
+	require.Error(t, err)
+	provider.aspectCtx.SetEthBlockContext(types.NewEthBlockContextFromHeight(8))
+	header, err := provider.LastBlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(8), header.Number())
+}
+
+func TestSubmitTxToCurrentProposal(t *testing.T) {
+	tx, err := newBaseLayerTx(ethereum.NewTx(&ethereum.LegacyTx{Nonce: 1, To: &testTo, Gas: 21000}), testFrom)
+	require.NoError(t, err)
+
+	// the txs cannot be submitted without the queue, e.g. in check tx
+	provider := newTestProvider(&testEVMKeeper{})
+	require.ErrorIs(t, provider.SubmitTxToCurrentProposal(tx), types.ErrNoOpenProposal)
+
+	// or while no block is being executed
+	queue := types.NewProposalTxQueue(types.DefaultProposalTxQueueSize)
+	provider.WithProposalTxQueue(queue)
+	require.ErrorIs(t, provider.SubmitTxToCurrentProposal(tx), types.ErrNoOpenProposal)
+
+	queue.Open()
+	require.NoError(t, provider.SubmitTxToCurrentProposal(tx))
+	queue.Close()
+	require.Len(t, queue.Drain(), 1)
+}
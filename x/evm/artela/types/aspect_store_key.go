@@ -40,6 +40,12 @@ const (
 	AspectPropertyLimit        = math.MaxUint8
 )
 
+// IsReservedPropertyKey reports whether the aspect property key is kept for the
+// system contract, which can't be set by the aspect owner and is not exposed to queries.
+func IsReservedPropertyKey(key string) bool {
+	return key == AspectAccountKey || key == AspectProofKey
+}
+
 var (
 	PathSeparator    = []byte("/")
 	PathSeparatorLen = len(PathSeparator)
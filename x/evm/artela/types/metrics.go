@@ -0,0 +1,93 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aspecttypes "github.com/artela-network/aspect-core/types"
+	runtimetypes "github.com/artela-network/aspect-runtime/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// Metrics of the aspect runtime, the runners are taken from the apply pool when the
+// execution is committed, and from the query pool otherwise.
+var (
+	applyPoolCapacityGauge = metrics.NewRegisteredGauge("aspect/pool/apply/capacity", nil)
+	queryPoolCapacityGauge = metrics.NewRegisteredGauge("aspect/pool/query/capacity", nil)
+
+	applyPoolMetrics = newPoolMetrics("apply")
+	queryPoolMetrics = newPoolMetrics("query")
+)
+
+// Debug messages logged by the runtime pool of aspect-runtime when a runner is taken
+// from the pool, reused or created, and when it is returned to the pool.
+const (
+	poolHitMsg    = "runtime pool cache hit"
+	poolMissMsg   = "runtime pool cache miss"
+	poolReturnMsg = "runtime returned"
+)
+
+// poolMetrics counts the runtimes taken from and returned to a runtime pool. The runtimes
+// in use are the ones taken but not returned yet, the counters are never decremented, so
+// that the runtimes lost by a failed execution show up as a lasting gap instead of a gauge
+// drifting away.
+type poolMetrics struct {
+	hit      metrics.Counter // runtimes reused from the pool
+	miss     metrics.Counter // runtimes created as none could be reused
+	returned metrics.Counter // runtimes returned to the pool
+}
+
+func newPoolMetrics(pool string) *poolMetrics {
+	return &poolMetrics{
+		hit:      metrics.NewRegisteredCounter(fmt.Sprintf("aspect/pool/%s/hit", pool), nil),
+		miss:     metrics.NewRegisteredCounter(fmt.Sprintf("aspect/pool/%s/miss", pool), nil),
+		returned: metrics.NewRegisteredCounter(fmt.Sprintf("aspect/pool/%s/returned", pool), nil),
+	}
+}
+
+// InitRunnerPools initializes the apply and the query runtime pools of aspect-core, each pool
+// reports its runtimes to its own metrics.
+func InitRunnerPools(ctx context.Context, logger runtimetypes.Logger, applyPoolSize, queryPoolSize int32) {
+	// aspect-core creates both pools with the same logger, so the apply pool is kept from
+	// a first initialization, and the query pool from a second one.
+	aspecttypes.InitRuntimePool(ctx, newPoolLogger(logger, applyPoolMetrics), applyPoolSize, queryPoolSize)
+	applyPool := *aspecttypes.RunnerPool(true)
+	aspecttypes.InitRuntimePool(ctx, newPoolLogger(logger, queryPoolMetrics), applyPoolSize, queryPoolSize)
+	*aspecttypes.RunnerPool(true) = applyPool
+
+	applyPoolCapacityGauge.Update(int64(applyPoolSize))
+	queryPoolCapacityGauge.Update(int64(queryPoolSize))
+}
+
+// poolLogger counts the runtimes taken from and returned to a runtime pool, which the pools
+// of aspect-runtime only report through their debug logs.
+type poolLogger struct {
+	runtimetypes.Logger
+	metrics *poolMetrics
+}
+
+func newPoolLogger(logger runtimetypes.Logger, metrics *poolMetrics) runtimetypes.Logger {
+	return &poolLogger{Logger: logger, metrics: metrics}
+}
+
+func (l *poolLogger) Debug(msg string, keyvals ...interface{}) {
+	switch msg {
+	case poolHitMsg:
+		l.metrics.hit.Inc(1)
+	case poolMissMsg:
+		l.metrics.miss.Inc(1)
+	case poolReturnMsg:
+		l.metrics.returned.Inc(1)
+	}
+	l.Logger.Debug(msg, keyvals...)
+}
+
+func (l *poolLogger) With(keyvals ...interface{}) runtimetypes.Logger {
+	return &poolLogger{Logger: l.Logger.With(keyvals...), metrics: l.metrics}
+}
+
+// UpdateJoinPointTimer records the time taken by an aspect executed at the join point.
+func UpdateJoinPointTimer(joinPoint string, elapsed time.Duration) {
+	metrics.GetOrRegisterTimer(fmt.Sprintf("aspect/joinpoint/%s/duration", joinPoint), nil).Update(elapsed)
+}
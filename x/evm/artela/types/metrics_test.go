@@ -0,0 +1,82 @@
+package types
+
+import (
+	"context"
+	"crypto/sha1" // #nosec G505 -- mirrors the runtime hash of the pool
+	"encoding/hex"
+	"testing"
+
+	aspecttypes "github.com/artela-network/aspect-core/types"
+	runtime "github.com/artela-network/aspect-runtime"
+	runtimetypes "github.com/artela-network/aspect-runtime/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type nopRuntimeLogger struct{}
+
+func (nopRuntimeLogger) Debug(string, ...interface{})              {}
+func (nopRuntimeLogger) Info(string, ...interface{})               {}
+func (nopRuntimeLogger) Error(string, ...interface{})              {}
+func (l nopRuntimeLogger) With(...interface{}) runtimetypes.Logger { return l }
+
+// testRuntime is a runtime which can be reused by the pool.
+type testRuntime struct {
+	runtimetypes.AspectRuntime
+}
+
+func (testRuntime) Reset()                                                          {}
+func (testRuntime) Destroy()                                                        {}
+func (testRuntime) ResetStore(context.Context, *runtimetypes.HostAPIRegistry) error { return nil }
+
+func newTestPoolMetrics() *poolMetrics {
+	return &poolMetrics{
+		hit:      metrics.NewCounterForced(),
+		miss:     metrics.NewCounterForced(),
+		returned: metrics.NewCounterForced(),
+	}
+}
+
+func TestInitRunnerPools(t *testing.T) {
+	// the metrics are disabled in the tests
+	applyMetrics, queryMetrics := applyPoolMetrics, queryPoolMetrics
+	applyPoolMetrics, queryPoolMetrics = newTestPoolMetrics(), newTestPoolMetrics()
+	t.Cleanup(func() { applyPoolMetrics, queryPoolMetrics = applyMetrics, queryMetrics })
+	InitRunnerPools(context.Background(), nopRuntimeLogger{}, 2, 2)
+
+	// the key of a pooled runtime is suffixed with the hash of its type and code
+	code := []byte("code")
+	hash := sha1.Sum(append([]byte{byte(runtime.WASM)}, code...)) // #nosec G401
+	key := "runtime:" + hex.EncodeToString(hash[:])
+
+	// each pool reports its own runtimes
+	aspecttypes.RunnerPool(false).Return(key, testRuntime{})
+	require.Equal(t, int64(1), queryPoolMetrics.returned.Count())
+	require.Equal(t, int64(0), applyPoolMetrics.returned.Count())
+
+	// the runtime returned is reused
+	reusedKey, rt, err := aspecttypes.RunnerPool(false).Runtime(context.Background(), nopRuntimeLogger{}, code, nil)
+	require.NoError(t, err)
+	require.Equal(t, key, reusedKey)
+	require.Equal(t, testRuntime{}, rt)
+	require.Equal(t, int64(1), queryPoolMetrics.hit.Count())
+	require.Equal(t, int64(0), queryPoolMetrics.miss.Count())
+	require.Equal(t, int64(0), applyPoolMetrics.hit.Count())
+
+	aspecttypes.RunnerPool(true).Return(key, testRuntime{})
+	require.Equal(t, int64(1), applyPoolMetrics.returned.Count())
+	require.Equal(t, int64(1), queryPoolMetrics.returned.Count())
+}
+
+func TestPoolLogger(t *testing.T) {
+	poolMetrics := newTestPoolMetrics()
+	logger := newPoolLogger(nopRuntimeLogger{}, poolMetrics).With("module", "aspect")
+
+	logger.Debug(poolMissMsg)
+	logger.Debug(poolReturnMsg)
+	// other messages are not counted
+	logger.Debug("runtime destroyed")
+	require.Equal(t, int64(0), poolMetrics.hit.Count())
+	require.Equal(t, int64(1), poolMetrics.miss.Count())
+	require.Equal(t, int64(1), poolMetrics.returned.Count())
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/aspect-core/integration"
+)
+
+// DefaultProposalTxQueueSize is the max number of pending txs submitted by aspects.
+const DefaultProposalTxQueueSize = 1024
+
+// ErrNoOpenProposal is returned if a tx is submitted while no block is being executed.
+var ErrNoOpenProposal = errors.New("no proposal is open for the submitted txs")
+
+// ProposalTxQueue holds the txs submitted by aspects through SubmitTxToCurrentProposal,
+// e.g. the JIT inherent txs. The block being executed cannot be modified anymore, so the
+// txs are placed in the next block proposal prepared by this node.
+//
+// The queue only accepts txs between Open and Close, which are called at the begin and the
+// end of the block execution on every node, so the txs submitted while preparing or processing
+// a proposal are rejected the same way on every node.
+type ProposalTxQueue struct {
+	mu     sync.Mutex
+	size   int
+	open   bool
+	txs    []integration.BaseLayerTx
+	hashes map[common.Hash]struct{}
+}
+
+// NewProposalTxQueue creates a queue holding at most size txs.
+func NewProposalTxQueue(size int) *ProposalTxQueue {
+	return &ProposalTxQueue{
+		size:   size,
+		hashes: make(map[common.Hash]struct{}),
+	}
+}
+
+// Open starts accepting the txs of the block being executed. The txs left from the previous
+// block are dropped, the next proposal has been prepared by another node already.
+func (q *ProposalTxQueue) Open() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.open = true
+	q.txs = nil
+	q.hashes = make(map[common.Hash]struct{})
+}
+
+// Close stops accepting txs until the next block is executed.
+func (q *ProposalTxQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.open = false
+}
+
+// Submit queues the tx for the next proposal. ErrNoOpenProposal is returned if the queue is
+// closed. The tx is ignored if it has been queued already, and the oldest tx is dropped if the
+// queue is full.
+func (q *ProposalTxQueue) Submit(tx integration.BaseLayerTx) error {
+	if tx == nil {
+		return errors.New("nil proposal tx")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.open {
+		return ErrNoOpenProposal
+	}
+	if q.size <= 0 {
+		return nil
+	}
+
+	hash := common.BytesToHash(tx.Hash())
+	if _, ok := q.hashes[hash]; ok {
+		return nil
+	}
+	if len(q.txs) >= q.size {
+		delete(q.hashes, common.BytesToHash(q.txs[0].Hash()))
+		q.txs = q.txs[1:]
+	}
+
+	q.txs = append(q.txs, tx)
+	q.hashes[hash] = struct{}{}
+	return nil
+}
+
+// Drain returns all the queued txs in the order of submission, and empties the queue.
+func (q *ProposalTxQueue) Drain() []integration.BaseLayerTx {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	txs := q.txs
+	q.txs = nil
+	q.hashes = make(map[common.Hash]struct{})
+	return txs
+}
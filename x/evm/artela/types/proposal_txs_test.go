@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/aspect-core/integration"
+)
+
+type testBaseLayerTx byte
+
+func (tx testBaseLayerTx) Bytes() []byte     { return []byte{byte(tx)} }
+func (tx testBaseLayerTx) Hash() []byte      { return []byte{byte(tx)} }
+func (tx testBaseLayerTx) Sender() []byte    { return nil }
+func (tx testBaseLayerTx) Recipient() []byte { return nil }
+
+func TestProposalTxQueue(t *testing.T) {
+	q := NewProposalTxQueue(2)
+
+	// the txs are rejected outside of the block execution
+	require.ErrorIs(t, q.Submit(testBaseLayerTx(1)), ErrNoOpenProposal)
+	require.Empty(t, q.Drain())
+
+	q.Open()
+	require.NoError(t, q.Submit(testBaseLayerTx(1)))
+	require.NoError(t, q.Submit(testBaseLayerTx(1)))
+	require.Error(t, q.Submit(nil))
+	require.NoError(t, q.Submit(testBaseLayerTx(2)))
+	// the oldest tx is dropped once the queue is full
+	require.NoError(t, q.Submit(testBaseLayerTx(3)))
+	q.Close()
+	require.ErrorIs(t, q.Submit(testBaseLayerTx(4)), ErrNoOpenProposal)
+
+	require.Equal(t, []integration.BaseLayerTx{testBaseLayerTx(2), testBaseLayerTx(3)}, q.Drain())
+	require.Empty(t, q.Drain())
+
+	// the txs left from the previous block are dropped when the next block starts
+	q.Open()
+	require.NoError(t, q.Submit(testBaseLayerTx(1)))
+	q.Close()
+	q.Open()
+	require.NoError(t, q.Submit(testBaseLayerTx(2)))
+	q.Close()
+	require.Equal(t, []integration.BaseLayerTx{testBaseLayerTx(2)}, q.Drain())
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetAspectCmd(),
 	)
 	return cmd
 }
@@ -133,3 +137,271 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAspectCmd returns the parent command for all aspect query commands
+func GetAspectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "aspect",
+		Short:                      "Querying commands for the aspects",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetAspectsCmd(),
+		GetAspectCodeCmd(),
+		GetAspectJoinPointCmd(),
+		GetAspectPropertiesCmd(),
+		GetAspectBoundAccountsCmd(),
+		GetAspectBindingsCmd(),
+	)
+	return cmd
+}
+
+// GetAspectsCmd queries all the deployed aspects
+func GetAspectsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Gets all the deployed aspects",
+		Long:  "Gets all the deployed aspects with their latest versions.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			req := &txs.QueryAspectsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Aspects(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "aspects")
+	return cmd
+}
+
+// GetAspectCodeCmd queries the code of an aspect
+func GetAspectCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code ASPECT_ID [VERSION]",
+		Short: "Gets code of an aspect",
+		Long:  "Gets code of an aspect at the given version. If the version is not provided, it will use the latest version of the aspect.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			aspectId, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			version, err := parseAspectVersion(args[1:])
+			if err != nil {
+				return err
+			}
+
+			req := &txs.QueryAspectCodeRequest{
+				AspectId: aspectId,
+				Version:  version,
+			}
+
+			res, err := queryClient.AspectCode(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectJoinPointCmd queries the join points of an aspect
+func GetAspectJoinPointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-point ASPECT_ID [VERSION]",
+		Short: "Gets join points of an aspect",
+		Long:  "Gets join points of an aspect at the given version. If the version is not provided, it will use the latest version of the aspect.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			aspectId, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			version, err := parseAspectVersion(args[1:])
+			if err != nil {
+				return err
+			}
+
+			req := &txs.QueryAspectJoinPointRequest{
+				AspectId: aspectId,
+				Version:  version,
+			}
+
+			res, err := queryClient.AspectJoinPoint(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectPropertiesCmd queries the properties of an aspect
+func GetAspectPropertiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "properties ASPECT_ID",
+		Short: "Gets properties of an aspect",
+		Long:  "Gets all the properties of an aspect.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			aspectId, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &txs.QueryAspectPropertiesRequest{
+				AspectId: aspectId,
+			}
+
+			res, err := queryClient.AspectProperties(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectBoundAccountsCmd queries the accounts bound to an aspect
+func GetAspectBoundAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bound-accounts ASPECT_ID",
+		Short: "Gets accounts bound to an aspect",
+		Long:  "Gets all the accounts bound to an aspect.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			aspectId, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &txs.QueryAspectBoundAccountsRequest{
+				AspectId: aspectId,
+			}
+
+			res, err := queryClient.AspectBoundAccounts(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectBindingsCmd queries the aspects bound to an account
+func GetAspectBindingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bindings ADDRESS",
+		Short: "Gets aspects bound to an account",
+		Long:  "Gets the transaction level aspects bound to a contract and the verification aspects bound to an account.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &txs.QueryAspectBindingsRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.AspectBindings(rpc.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseAspectVersion parses the optional version argument, 0 stands for the latest version.
+func parseAspectVersion(args []string) (uint64, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid aspect version %s: %w", args[0], err)
+	}
+	return version, nil
+}
@@ -67,6 +67,8 @@ func InitGenesis(
 		}
 	}
 
+	k.InitAspects(ctx, genState.Aspects)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -97,5 +99,6 @@ func ExportGenesis(ctx cosmos.Context, k *keeper.Keeper, ak types.AccountKeeper)
 	return &support.GenesisState{
 		Accounts: ethGenAccounts,
 		Params:   k.GetParams(ctx),
+		Aspects:  k.ExportAspects(ctx),
 	}
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 
+	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/artela-network/artela/x/evm/artela/contract"
 	artvmtype "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
 )
 
 func (k Keeper) GetAspectRuntimeContext() *artvmtype.AspectRuntimeContext {
@@ -50,3 +53,13 @@ func (k Keeper) SetAspectContext(ctx context.Context, address common.Address, ke
 func (k Keeper) GetBlockContext() *artvmtype.EthBlockContext {
 	return k.BlockContext
 }
+
+// ExportAspects exports the aspect store data for the genesis state.
+func (k Keeper) ExportAspects(ctx cosmos.Context) support.GenesisAspects {
+	return contract.NewAspectStore(k.storeKey, k.logger).ExportGenesis(ctx)
+}
+
+// InitAspects initializes the aspect store with the data of the genesis state.
+func (k Keeper) InitAspects(ctx cosmos.Context, aspects support.GenesisAspects) {
+	contract.NewAspectStore(k.storeKey, k.logger).InitGenesis(ctx, aspects)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+var _ asptypes.AspectLogger = (*aspectExecutionRecorder)(nil)
+
+// aspectExecutionRecorder records the aspects executed at the transaction level join points,
+// the captured events are forwarded to the wrapped logger if there is one. The execution time
+// of each aspect is reported to the metrics. The versions of the aspects are only resolved
+// if versionOf is set.
+type aspectExecutionRecorder struct {
+	logger    asptypes.AspectLogger
+	versionOf func(contract, aspectId common.Address) uint64
+
+	executions []support.AspectExecution
+	// running keeps the aspects entered but not exited yet, the aspects of the nested join points
+	// are entered before the outer ones exit.
+	running []aspectFrame
+}
+
+// aspectFrame is an aspect entered by the recorder.
+type aspectFrame struct {
+	execution int
+	startGas  uint64
+	startTime time.Time
+}
+
+func newAspectExecutionRecorder(logger asptypes.AspectLogger, versionOf func(contract, aspectId common.Address) uint64) *aspectExecutionRecorder {
+	return &aspectExecutionRecorder{
+		logger:    logger,
+		versionOf: versionOf,
+	}
+}
+
+func (r *aspectExecutionRecorder) CaptureAspectEnter(joinpoint asptypes.JoinPointRunType, from, to, aspectId common.Address, input []byte, gas uint64, value *big.Int, execCtx proto.Message) {
+	r.running = append(r.running, aspectFrame{
+		execution: len(r.executions),
+		startGas:  gas,
+		startTime: time.Now(),
+	})
+	r.executions = append(r.executions, support.AspectExecution{
+		JoinPoint: joinpoint.String(),
+		AspectId:  aspectId.Hex(),
+	})
+	if r.versionOf != nil {
+		r.executions[len(r.executions)-1].Version = r.versionOf(to, aspectId)
+	}
+
+	if r.logger != nil {
+		r.logger.CaptureAspectEnter(joinpoint, from, to, aspectId, input, gas, value, execCtx)
+	}
+}
+
+func (r *aspectExecutionRecorder) CaptureAspectExit(joinpoint asptypes.JoinPointRunType, result *asptypes.AspectExecutionResult) {
+	if len(r.running) > 0 {
+		frame := r.running[len(r.running)-1]
+		r.running = r.running[:len(r.running)-1]
+
+		artelatypes.UpdateJoinPointTimer(joinpoint.String(), time.Since(frame.startTime))
+
+		if result != nil {
+			execution := &r.executions[frame.execution]
+			if frame.startGas > result.Gas {
+				execution.GasUsed = frame.startGas - result.Gas
+			}
+			if result.Err != nil {
+				execution.Error = result.Err.Error()
+			}
+		}
+	}
+
+	if r.logger != nil {
+		r.logger.CaptureAspectExit(joinpoint, result)
+	}
+}
+
+// boundAspectVersion returns the version of the aspect bound to the contract,
+// or the last version of the aspect if the binding is not found. The store is read
+// without charging gas, so that recording the versions never changes the gas of the tx.
+func boundAspectVersion(ctx cosmos.Context, store *contract.AspectStore, contractAddr, aspectId common.Address) uint64 {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if bindings, err := store.GetTxLevelAspects(ctx, contractAddr); err == nil {
+		for _, binding := range bindings {
+			if binding.Id == aspectId && binding.Version != nil {
+				return binding.Version.Uint64()
+			}
+		}
+	}
+	return store.GetAspectLastVersion(ctx, aspectId).Uint64()
+}
+
+// Executions returns the recorded aspect executions in the order they were run.
+func (r *aspectExecutionRecorder) Executions() []support.AspectExecution {
+	return r.executions
+}
+
+// emitAspectExecutions emits the aspect executions of the eth tx as an event, nothing
+// is emitted if no aspect was executed. The records are kept out of the tx response,
+// since the response is part of the consensus results.
+func emitAspectExecutions(ctx cosmos.Context, txHash common.Hash, executions []support.AspectExecution) error {
+	if len(executions) == 0 {
+		return nil
+	}
+
+	attrs := make([]cosmos.Attribute, 0, len(executions)+1)
+	attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyEthereumTxHash, txHash.Hex()))
+	for _, execution := range executions {
+		value, err := json.Marshal(execution)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode aspect execution")
+		}
+		attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyAspectExecution, string(value)))
+	}
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(types.EventTypeAspectExecution, attrs...))
+	return nil
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela/ethereum/rpc/utils"
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// testAspectLogger records the join points captured by the tracer.
+type testAspectLogger struct {
+	entered []asptypes.JoinPointRunType
+	exited  []asptypes.JoinPointRunType
+}
+
+func (l *testAspectLogger) CaptureAspectEnter(joinpoint asptypes.JoinPointRunType, _, _, _ common.Address, _ []byte, _ uint64, _ *big.Int, _ proto.Message) {
+	l.entered = append(l.entered, joinpoint)
+}
+
+func (l *testAspectLogger) CaptureAspectExit(joinpoint asptypes.JoinPointRunType, _ *asptypes.AspectExecutionResult) {
+	l.exited = append(l.exited, joinpoint)
+}
+
+func TestAspectExecutionRecorder(t *testing.T) {
+	contractAddr := common.HexToAddress("0x1")
+	aspect1, aspect2 := common.HexToAddress("0x2"), common.HexToAddress("0x3")
+
+	logger := &testAspectLogger{}
+	recorder := newAspectExecutionRecorder(logger, func(contract, aspectId common.Address) uint64 {
+		require.Equal(t, contractAddr, contract)
+		return uint64(aspectId.Big().Int64())
+	})
+
+	// the aspect of the nested join point exits before the outer one
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, common.Address{}, contractAddr, aspect1, nil, 50000, nil, nil)
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, common.Address{}, contractAddr, aspect2, nil, 40000, nil, nil)
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 30000})
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 20000, Err: errors.New("reverted")})
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PostTxExecute, common.Address{}, contractAddr, aspect2, nil, 10000, nil, nil)
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PostTxExecute, nil)
+
+	require.Equal(t, []support.AspectExecution{
+		{JoinPoint: asptypes.JoinPointRunType_PreContractCall.String(), AspectId: aspect1.Hex(), Version: 2, GasUsed: 30000, Error: "reverted"},
+		{JoinPoint: asptypes.JoinPointRunType_PreContractCall.String(), AspectId: aspect2.Hex(), Version: 3, GasUsed: 10000},
+		{JoinPoint: asptypes.JoinPointRunType_PostTxExecute.String(), AspectId: aspect2.Hex(), Version: 3},
+	}, recorder.Executions())
+
+	// the captured join points are forwarded to the tracer
+	require.Len(t, logger.entered, 3)
+	require.Len(t, logger.exited, 3)
+
+	// the versions are not resolved without versionOf
+	recorder = newAspectExecutionRecorder(nil, nil)
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PreTxExecute, common.Address{}, contractAddr, aspect1, nil, 10000, nil, nil)
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PreTxExecute, &asptypes.AspectExecutionResult{Gas: 4000})
+	require.Equal(t, []support.AspectExecution{
+		{JoinPoint: asptypes.JoinPointRunType_PreTxExecute.String(), AspectId: aspect1.Hex(), GasUsed: 6000},
+	}, recorder.Executions())
+}
+
+func TestBoundAspectVersion(t *testing.T) {
+	key := storetypes.NewKVStoreKey("evm")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_evm"))
+	store := contract.NewAspectStore(key, log.NewNopLogger())
+
+	contractAddr := common.HexToAddress("0x1")
+	aspectID := common.HexToAddress("0x2")
+	for i := 0; i < 2; i++ {
+		version, _, err := store.BumpAspectVersion(ctx, aspectID, testGas)
+		require.NoError(t, err)
+		_, err = store.StoreAspectCode(ctx, aspectID, []byte{0x1}, version, testGas)
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.BindTxAspect(ctx, contractAddr, aspectID, uint256.NewInt(1), 0))
+
+	// the store is read without charging the gas of the tx
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000000))
+	require.Equal(t, uint64(1), boundAspectVersion(ctx, store, contractAddr, aspectID))
+	require.Equal(t, uint64(2), boundAspectVersion(ctx, store, common.HexToAddress("0x3"), aspectID))
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+}
+
+func TestEmitAspectExecutions(t *testing.T) {
+	ctx := cosmos.Context{}.WithEventManager(cosmos.NewEventManager())
+	txHash := common.HexToHash("0x1")
+
+	// nothing is emitted for the txs without aspects
+	require.NoError(t, emitAspectExecutions(ctx, txHash, nil))
+	require.Empty(t, ctx.EventManager().Events())
+
+	executions := []support.AspectExecution{
+		{JoinPoint: asptypes.JoinPointRunType_PreTxExecute.String(), AspectId: common.HexToAddress("0x2").Hex(), Version: 1, GasUsed: 100},
+		{JoinPoint: asptypes.JoinPointRunType_PostTxExecute.String(), AspectId: common.HexToAddress("0x2").Hex(), Version: 1, Error: "reverted"},
+	}
+	require.NoError(t, emitAspectExecutions(ctx, txHash, executions))
+	other := []support.AspectExecution{{JoinPoint: asptypes.JoinPointRunType_PreTxExecute.String(), AspectId: common.HexToAddress("0x3").Hex()}}
+	require.NoError(t, emitAspectExecutions(ctx, common.HexToHash("0x2"), other))
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeAspectExecution, events[0].Type)
+
+	// the receipt finds the executions of the tx by its hash
+	res, err := utils.TxAspectExecutionsFromEvents(events, txHash)
+	require.NoError(t, err)
+	require.Equal(t, executions, res)
+	res, err = utils.TxAspectExecutionsFromEvents(events, common.HexToHash("0x2"))
+	require.NoError(t, err)
+	require.Equal(t, other, res)
+	res, err = utils.TxAspectExecutionsFromEvents(events, common.HexToHash("0x4"))
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
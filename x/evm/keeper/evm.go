@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	cometbft "github.com/cometbft/cometbft/types"
@@ -50,6 +51,7 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := artcore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -138,6 +140,7 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		bloom        *big.Int
 		bloomReceipt ethereum.Bloom
 	)
+	start := time.Now()
 
 	// build evm config and txs config
 	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
@@ -173,8 +176,12 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		return nil, errorsmod.Wrap(err, "unable to process msg data")
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, aspectCtx, msg, nil, true, evmConfig, txConfig, k.isCustomizedVerification(tx))
+	// pass true to commit the StateDB, and record the versions of the executed aspects
+	aspectStore := contract.NewAspectStore(k.storeKey, k.logger)
+	res, aspectExecutions, err := k.applyMessageWithConfig(tmpCtx, aspectCtx, msg, nil, true, evmConfig, txConfig, k.isCustomizedVerification(tx),
+		func(contractAddr, aspectId common.Address) uint64 {
+			return boundAspectVersion(tmpCtx, aspectStore, contractAddr, aspectId)
+		})
 	if err != nil {
 		ctx.Logger().Error("ApplyMessageWithConfig with error", "txhash", tx.Hash().String(), "error", err, "response", res)
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
@@ -231,6 +238,11 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		ctx.Logger().Debug("apply transaction failed", "tx hash", txConfig.TxHash.String(), "error", res.VmError)
 	}
 
+	// the aspect executions are recorded for the failed txs as well, since aspects may revert them
+	if err := emitAspectExecutions(ctx, txConfig.TxHash, aspectExecutions); err != nil {
+		return nil, err
+	}
+
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
 	if err = k.RefundGas(ctx, msg, msg.GasLimit-res.GasUsed, evmConfig.Params.EvmDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
@@ -252,6 +264,9 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	// reset the gas meter for current cosmos txs
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 
+	applyTxTimer.UpdateSince(start)
+	applyTxGasHistogram.Update(int64(res.GasUsed))
+
 	return res, nil
 }
 
@@ -320,6 +335,23 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	txConfig states.TxConfig,
 	isCustomVerification bool,
 ) (*txs.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessageWithConfig(ctx, aspectCtx, msg, tracer, commit, cfg, txConfig, isCustomVerification, nil)
+	return res, err
+}
+
+// applyMessageWithConfig applies the message as ApplyMessageWithConfig does, and returns the aspects
+// executed at the transaction level join points. The versions of the executed aspects are resolved
+// with versionOf if it is set.
+func (k *Keeper) applyMessageWithConfig(ctx cosmos.Context,
+	aspectCtx *artelatypes.AspectRuntimeContext,
+	msg *core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *states.EVMConfig,
+	txConfig states.TxConfig,
+	isCustomVerification bool,
+	versionOf func(contractAddr, aspectId common.Address) uint64,
+) (*txs.MsgEthereumTxResponse, []support.AspectExecution, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
@@ -327,12 +359,17 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
 	stateDB := states.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "failed to apply state override")
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	// Aspect Runtime Context Lifecycle: set EVM params.
@@ -359,6 +396,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		aspectLogger, _ = tracer.(asptypes.AspectLogger)
 	}
 
+	// record the aspects executed at the transaction level join points
+	aspectRecorder := newAspectExecutionRecorder(aspectLogger, versionOf)
+
 	sender := vm.AccountRef(msg.From)
 	contractCreation := msg.To == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
@@ -366,20 +406,20 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation, isCustomVerification)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix())); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
+		stateDB.PrepareAccessList(msg.From, msg.To, k.precompiles.ActivePrecompiles(ctx, rules, cfg.Params), msg.AccessList)
 	}
 	lastHeight := uint64(ctx.BlockHeight())
 	// if transaction is Aspect operational, short the circuit and skip the processes
@@ -404,7 +444,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 				From: msg.From.Bytes(),
 			},
 			Block: &asptypes.BlockInput{Number: &lastHeight},
-		}, aspectLogger)
+		}, aspectRecorder)
 
 		leftoverGas = preTxResult.Gas
 		if preTxResult.Err != nil {
@@ -464,7 +504,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 						},
 						Block:   &asptypes.BlockInput{Number: &lastHeight},
 						Receipt: &asptypes.ReceiptInput{Status: &status},
-					}, aspectLogger)
+					}, aspectRecorder)
 				if postTxResult.Err != nil {
 					// overwrite vmErr if post tx reverted
 					if postTxResult.Err.Error() == vm.ErrOutOfGas.Error() {
@@ -488,7 +528,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 
 	// calculate gas refund
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.GasLimit - leftoverGas
@@ -507,7 +547,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -519,7 +559,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
 	}
 
 	gasUsed := cosmos.MaxDec(minimumGasUsed, cosmos.NewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
@@ -532,5 +572,5 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		Ret:     ret,
 		Logs:    support.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, aspectRecorder.Executions(), nil
 }
@@ -17,11 +17,13 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/artela-network/artela-evm/tracers"
 	"github.com/artela-network/artela-evm/tracers/logger"
 	"github.com/artela-network/artela-evm/vm"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/artela/provider"
 	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
@@ -240,8 +242,12 @@ func (k Keeper) EthCall(c context.Context, req *txs.EthCallRequest) (*txs.MsgEth
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -289,6 +295,23 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	gas, err := k.EstimateGasWithConfig(ctx, args, req.GasCap, cfg, artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
+	if err != nil {
+		return nil, err
+	}
+	return &txs.EstimateGasResponse{Gas: gas}, nil
+}
+
+// EstimateGasWithConfig binary searches the gas needed by the txs args with the given evm config,
+// the txs args are executed on top of the state of the cosmos context without committing.
+func (k Keeper) EstimateGasWithConfig(ctx cosmos.Context, args txs.TransactionArgs, gasCapLimit uint64,
+	cfg *states.EVMConfig, blockCtx *artelatypes.EthBlockContext,
+) (uint64, error) {
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -305,22 +328,17 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		if params != nil && params.Block != nil && params.Block.MaxGas > 0 {
 			hi = uint64(params.Block.MaxGas)
 		} else {
-			hi = req.GasCap
+			hi = gasCapLimit
 		}
 	}
 
 	// Recap the highest gas allowance with specified gascap.
-	if req.GasCap != 0 && hi > req.GasCap {
-		hi = req.GasCap
+	if gasCapLimit != 0 && hi > gasCapLimit {
+		hi = gasCapLimit
 	}
 	txMsg := args.ToTransaction()
 
 	gasCap = hi
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
-	}
-
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -328,9 +346,9 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
 	// convert the txs args to an ethereum message
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	msg, err := args.ToMessage(gasCapLimit, cfg.BaseFee)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return 0, status.Error(codes.Internal, err.Error())
 	}
 
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
@@ -345,8 +363,7 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		// Aspect Runtime Context Lifecycle: create aspect context.
 		// This marks the beginning of running an aspect of EstimateGas, creating the aspect context,
 		// and establishing the link with the SDK context.
-		cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, txMsg.AsTransaction(), cfg,
-			artelatypes.NewEthBlockContextFromQuery(tmpCtx, k.clientContext))
+		cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, txMsg.AsTransaction(), cfg, blockCtx)
 		defer aspectCtx.Destroy()
 
 		// update the message with the new gas value
@@ -365,28 +382,116 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	// Execute the binary search and hone in on an executable gas limit
 	hi, err = txs.BinSearch(lo, hi, executable)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Reject the txs as invalid if it still fails at the highest allowance
 	if hi == gasCap {
 		failed, result, err := executable(hi)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		if failed {
 			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
 				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
+					return 0, types.NewExecErrorWithReason(result.Ret)
 				}
-				return nil, errors.New(result.VmError)
+				return 0, errors.New(result.VmError)
 			}
 			// Otherwise, the specified gas cap is too low
-			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+			return 0, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+		}
+	}
+	return hi, nil
+}
+
+// CreateAccessList implements the `eth_createAccessList` rpc api.
+// The message is executed with an access list tracer repeatedly, each run takes the access list
+// generated by the previous one, until the access list doesn't change anymore.
+func (k Keeper) CreateAccessList(c context.Context, req *txs.EthCallRequest) (*txs.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args txs.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.callNonce(ctx, cfg, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are excluded from the access list
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	precompiles := k.precompiles.ActivePrecompiles(ctx, rules, cfg.Params)
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	isCustomVerification := len(args.GetValidationData()) > 0
+
+	// Create a helper to execute the message with the given access list tracer
+	execute := func(msg *core.Message, tracer *logger.AccessListTracer) (*txs.MsgEthereumTxResponse, error) {
+		// need to create a cache context here to avoid state change affecting each other
+		tmpCtx, _ := ctx.CacheContext()
+		// Aspect Runtime Context Lifecycle: create aspect context.
+		cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, args.ToTransaction().AsEthCallTransaction(), cfg,
+			artelatypes.NewEthBlockContextFromQuery(tmpCtx, k.clientContext))
+		defer aspectCtx.Destroy()
+
+		// pass false to not commit StateDB
+		return k.ApplyMessageWithConfig(cosmosCtx, aspectCtx, msg, tracer, false, cfg, txConfig, isCustomVerification)
+	}
+
+	// the access list provided by the caller is taken as the initial one
+	var prevAccessList ethereum.AccessList
+	if args.AccessList != nil {
+		prevAccessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(prevAccessList, from, to, precompiles)
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := execute(msg, tracer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to apply transaction: %s", err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &txs.CreateAccessListResponse{
+				AccessList: txs.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
 	}
-	return &txs.EstimateGasResponse{Gas: hi}, nil
 }
 
 // TraceTx configures a new tracer according to the provided configuration, and
@@ -515,13 +620,19 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 	txsLength := len(req.Txs)
 	results := make([]*txs.TxTraceResult, 0, txsLength)
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 	for i, tx := range req.Txs {
 		result := txs.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -541,6 +652,72 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the queried block, the state changes are not committed.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *txs.QueryTraceCallRequest) (*txs.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args txs.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	isCustomVerification := len(args.GetValidationData()) > 0
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, args.ToTransaction().AsEthCallTransaction(), msg,
+		req.TraceConfig, false, tracerConfig, isCustomVerification)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &txs.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one txs, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx cosmos.Context,
@@ -551,6 +728,26 @@ func (k *Keeper) traceTx(
 	traceConfig *support.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := txs.ToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, tx, msg, traceConfig, commitMessage, tracerJSONConfig, k.isCustomizedVerification(tx))
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx cosmos.Context,
+	cfg *states.EVMConfig,
+	txConfig states.TxConfig,
+	tx *ethereum.Transaction,
+	msg *core.Message,
+	traceConfig *support.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+	isCustomVerification bool,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -561,7 +758,7 @@ func (k *Keeper) traceTx(
 	)
 
 	// Aspect Runtime Context Lifecycle: create aspect context.
-	// This marks the beginning of running an aspect of TraceBlock or TraceTx, creating the aspect context,
+	// This marks the beginning of running an aspect of TraceBlock, TraceTx or TraceCall, creating the aspect context,
 	// and establishing the link with the SDK context.
 	cacheCtx, commit := ctx.CacheContext()
 	ctx, aspectCtx := k.WithAspectContext(cacheCtx, tx, cfg,
@@ -573,11 +770,6 @@ func (k *Keeper) traceTx(
 		aspectCtx.Destroy()
 	}()
 
-	msg, err := txs.ToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
-
 	if traceConfig == nil {
 		traceConfig = &support.TraceConfig{}
 	}
@@ -628,7 +820,6 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	isCustomVerification := k.isCustomizedVerification(tx)
 	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commitMessage, cfg, txConfig, isCustomVerification)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
@@ -689,7 +880,7 @@ func (k Keeper) WithAspectContext(ctx cosmos.Context, tx *ethereum.Transaction,
 	ethTxContext.WithEVMConfig(evmConf)
 
 	aspectCtx := artelatypes.NewAspectRuntimeContext()
-	protocol := provider.NewAspectProtocolProvider(aspectCtx.EthTxContext)
+	protocol := provider.NewAspectProtocolProvider(aspectCtx, &k)
 	jitManager := inherent.NewManager(protocol)
 
 	aspectCtx.SetEthTxContext(ethTxContext, jitManager)
@@ -706,3 +897,38 @@ func getChainID(ctx cosmos.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// setCallOverrides decodes the json encoded state and block overrides of a call request into the evm config.
+func setCallOverrides(cfg *states.EVMConfig, stateOverrides, blockOverridesBz []byte) error {
+	if len(stateOverrides) > 0 {
+		var overrides rpctypes.StateOverride
+		if err := json.Unmarshal(stateOverrides, &overrides); err != nil {
+			return err
+		}
+		cfg.Overrides = &overrides
+	}
+	if len(blockOverridesBz) > 0 {
+		var blockOverrides rpctypes.BlockOverrides
+		if err := json.Unmarshal(blockOverridesBz, &blockOverrides); err != nil {
+			return err
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return err
+		}
+		cfg.BlockOverrides = &blockOverrides
+		if blockOverrides.BaseFee != nil {
+			cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+		}
+	}
+	return nil
+}
+
+// callNonce returns the nonce of the sender of a call, taking the state overrides into account.
+func (k Keeper) callNonce(ctx cosmos.Context, cfg *states.EVMConfig, from common.Address) uint64 {
+	if cfg.Overrides != nil {
+		if account, ok := (*cfg.Overrides)[from]; ok && account.Nonce != nil {
+			return uint64(*account.Nonce)
+		}
+	}
+	return k.GetNonce(ctx, from)
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"github.com/holiman/uint256"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	artelasdkType "github.com/artela-network/aspect-core/types"
+)
+
+// Aspects implements the Query/Aspects gRPC method
+func (k Keeper) Aspects(c context.Context, req *txs.QueryAspectsRequest) (*txs.QueryAspectsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+
+	var aspects []txs.AspectVersion
+	pageRes, err := store.PaginateAspects(ctx, req.Pagination, func(aspectId common.Address, version *uint256.Int) {
+		aspects = append(aspects, txs.AspectVersion{
+			AspectId: aspectId.Hex(),
+			Version:  version.Uint64(),
+		})
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &txs.QueryAspectsResponse{
+		Aspects:    aspects,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AspectCode implements the Query/AspectCode gRPC method
+func (k Keeper) AspectCode(c context.Context, req *txs.QueryAspectCodeRequest) (*txs.QueryAspectCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+	aspectId := common.HexToAddress(req.AspectId)
+
+	version, err := aspectVersion(ctx, store, aspectId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	code, _ := store.GetAspectCode(ctx, aspectId, version)
+	return &txs.QueryAspectCodeResponse{
+		Code:    code,
+		Version: version.Uint64(),
+	}, nil
+}
+
+// AspectJoinPoint implements the Query/AspectJoinPoint gRPC method
+func (k Keeper) AspectJoinPoint(c context.Context, req *txs.QueryAspectJoinPointRequest) (*txs.QueryAspectJoinPointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+	aspectId := common.HexToAddress(req.AspectId)
+
+	version, err := aspectVersion(ctx, store, aspectId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	jp, err := store.GetAspectJP(ctx, aspectId, version)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	jpMap, _ := artelasdkType.CheckIsJoinPoint(jp)
+	values := make([]int64, 0, len(jpMap))
+	for value := range jpMap {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, jpMap[value])
+	}
+
+	return &txs.QueryAspectJoinPointResponse{
+		JoinPoint:      jp.Uint64(),
+		JoinPointNames: names,
+		Version:        version.Uint64(),
+	}, nil
+}
+
+// AspectProperties implements the Query/AspectProperties gRPC method
+func (k Keeper) AspectProperties(c context.Context, req *txs.QueryAspectPropertiesRequest) (*txs.QueryAspectPropertiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+
+	props := store.GetAspectProperties(ctx, common.HexToAddress(req.AspectId))
+	properties := make([]txs.AspectProperty, 0, len(props))
+	for _, prop := range props {
+		// the owner account and the proof are internal to the system contract
+		if types.IsReservedPropertyKey(prop.Key) {
+			continue
+		}
+		properties = append(properties, txs.AspectProperty{
+			Key:   prop.Key,
+			Value: prop.Value,
+		})
+	}
+
+	return &txs.QueryAspectPropertiesResponse{
+		Properties: properties,
+	}, nil
+}
+
+// AspectBoundAccounts implements the Query/AspectBoundAccounts gRPC method
+func (k Keeper) AspectBoundAccounts(c context.Context, req *txs.QueryAspectBoundAccountsRequest) (*txs.QueryAspectBoundAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+
+	refs, err := store.GetAspectRefValue(ctx, common.HexToAddress(req.AspectId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var accounts []string
+	if refs != nil {
+		accounts = make([]string, 0, refs.Size())
+		for _, ref := range refs.Values() {
+			accounts = append(accounts, common.HexToAddress(ref.(string)).Hex())
+		}
+	}
+
+	return &txs.QueryAspectBoundAccountsResponse{
+		Accounts: accounts,
+	}, nil
+}
+
+// AspectBindings implements the Query/AspectBindings gRPC method
+func (k Keeper) AspectBindings(c context.Context, req *txs.QueryAspectBindingsRequest) (*txs.QueryAspectBindingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	store := contract.NewAspectStore(k.storeKey, k.logger)
+	account := common.HexToAddress(req.Address)
+
+	txAspects, err := store.GetTxLevelAspects(ctx, account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	verifiers, err := store.GetVerificationAspects(ctx, account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &txs.QueryAspectBindingsResponse{
+		ContractBindings: make([]txs.AspectBinding, 0, len(txAspects)),
+		VerifierBindings: make([]txs.AspectBinding, 0, len(verifiers)),
+	}
+	for _, meta := range txAspects {
+		res.ContractBindings = append(res.ContractBindings, txs.AspectBinding{
+			AspectId: meta.Id.Hex(),
+			Version:  meta.Version.Uint64(),
+			Priority: meta.Priority,
+		})
+	}
+	for _, meta := range verifiers {
+		res.VerifierBindings = append(res.VerifierBindings, txs.AspectBinding{
+			AspectId: meta.Id.Hex(),
+			Version:  meta.Version.Uint64(),
+			Priority: meta.Priority,
+		})
+	}
+
+	return res, nil
+}
+
+// aspectVersion resolves the requested aspect version, 0 stands for the latest version.
+func aspectVersion(ctx cosmos.Context, store *contract.AspectStore, aspectId common.Address, requested uint64) (*uint256.Int, error) {
+	latest := store.GetAspectLastVersion(ctx, aspectId)
+	if latest.IsZero() {
+		return nil, status.Errorf(codes.NotFound, "aspect %s not found", aspectId.Hex())
+	}
+	if requested == 0 {
+		return latest, nil
+	}
+
+	version := uint256.NewInt(requested)
+	if version.Gt(latest) {
+		return nil, status.Errorf(codes.InvalidArgument, "aspect %s version %d not found", aspectId.Hex(), requested)
+	}
+	return version, nil
+}
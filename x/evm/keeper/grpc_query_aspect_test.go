@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	artelasdkType "github.com/artela-network/aspect-core/types"
+)
+
+const testGas = 100000000
+
+func TestAspectQueries(t *testing.T) {
+	key := storetypes.NewKVStoreKey("evm")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_evm"))
+	k := Keeper{storeKey: key, logger: log.NewNopLogger()}
+	store := contract.NewAspectStore(key, k.logger)
+	c := cosmos.WrapSDKContext(ctx)
+
+	aspectID := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	account := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	// two versions of a verifier aspect, deployed with the reserved properties
+	for _, code := range [][]byte{{0x1}, {0x2}} {
+		version, _, err := store.BumpAspectVersion(ctx, aspectID, testGas)
+		require.NoError(t, err)
+		_, err = store.StoreAspectCode(ctx, aspectID, code, version, testGas)
+		require.NoError(t, err)
+		store.StoreAspectJP(ctx, aspectID, *version, big.NewInt(int64(artelasdkType.JoinPointRunType_VerifyTx)))
+	}
+	_, err := store.StoreAspectProperty(ctx, aspectID, []types.Property{
+		{Key: "owner", Value: []byte{0x1}},
+		{Key: types.AspectAccountKey, Value: account.Bytes()},
+		{Key: types.AspectProofKey, Value: []byte{0x2}},
+	}, testGas)
+	require.NoError(t, err)
+	require.NoError(t, store.BindVerificationAspect(ctx, account, aspectID, store.GetAspectLastVersion(ctx, aspectID), 1, false))
+	require.NoError(t, store.StoreAspectRefValue(ctx, account, aspectID))
+
+	aspects, err := k.Aspects(c, &txs.QueryAspectsRequest{Pagination: &query.PageRequest{Limit: 10}})
+	require.NoError(t, err)
+	require.Equal(t, []txs.AspectVersion{{AspectId: aspectID.Hex(), Version: 2}}, aspects.Aspects)
+
+	code, err := k.AspectCode(c, &txs.QueryAspectCodeRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, &txs.QueryAspectCodeResponse{Code: []byte{0x2}, Version: 2}, code)
+	code, err = k.AspectCode(c, &txs.QueryAspectCodeRequest{AspectId: aspectID.Hex(), Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, code.Code)
+
+	jp, err := k.AspectJoinPoint(c, &txs.QueryAspectJoinPointRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(artelasdkType.JoinPointRunType_VerifyTx), jp.JoinPoint)
+	require.Equal(t, []string{string(artelasdkType.VERIFY_TX)}, jp.JoinPointNames)
+
+	// the account and the proof stored by the system contract are not exposed
+	properties, err := k.AspectProperties(c, &txs.QueryAspectPropertiesRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []txs.AspectProperty{{Key: "owner", Value: []byte{0x1}}}, properties.Properties)
+
+	accounts, err := k.AspectBoundAccounts(c, &txs.QueryAspectBoundAccountsRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []string{account.Hex()}, accounts.Accounts)
+
+	bindings, err := k.AspectBindings(c, &txs.QueryAspectBindingsRequest{Address: account.Hex()})
+	require.NoError(t, err)
+	require.Empty(t, bindings.ContractBindings)
+	require.Equal(t, []txs.AspectBinding{{AspectId: aspectID.Hex(), Version: 2, Priority: 1}}, bindings.VerifierBindings)
+
+	// unknown aspects, versions and invalid addresses
+	_, err = k.AspectCode(c, &txs.QueryAspectCodeRequest{AspectId: account.Hex()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = k.AspectJoinPoint(c, &txs.QueryAspectJoinPointRequest{AspectId: aspectID.Hex(), Version: 3})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.AspectProperties(c, &txs.QueryAspectPropertiesRequest{AspectId: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.AspectBindings(c, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+func TestSetCallOverrides(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	cfg := &states.EVMConfig{BaseFee: big.NewInt(1)}
+	require.NoError(t, setCallOverrides(cfg,
+		[]byte(`{"0x1000000000000000000000000000000000000001": {"nonce": "0x5"}}`),
+		[]byte(`{"number": "0x10", "baseFee": "0x40"}`)))
+	require.Contains(t, *cfg.Overrides, addr)
+	require.Equal(t, big.NewInt(0x10), cfg.BlockOverrides.Number.ToInt())
+	// the base fee of the call follows the overridden one
+	require.Equal(t, big.NewInt(0x40), cfg.BaseFee)
+
+	// no overrides
+	cfg = &states.EVMConfig{}
+	require.NoError(t, setCallOverrides(cfg, nil, nil))
+	require.Nil(t, cfg.Overrides)
+	require.Nil(t, cfg.BlockOverrides)
+
+	// the unsupported header fields are rejected instead of ignored
+	require.ErrorContains(t, setCallOverrides(&states.EVMConfig{}, nil, []byte(`{"difficulty": "0x1"}`)), "difficulty")
+	require.Error(t, setCallOverrides(&states.EVMConfig{}, []byte(`{"invalid"`), nil))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/artela-network/artela/x/evm/artela/api"
 	"github.com/artela-network/artela/x/evm/artela/provider"
 	artvmtype "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/precompile"
 	"github.com/artela-network/artela/x/evm/states"
 	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/txs/support"
@@ -84,6 +85,9 @@ type Keeper struct {
 
 	// cache of aspect sig
 	VerifySigCache *sync.Map
+
+	// stateful precompiled contracts giving the evm access to the cosmos modules
+	precompiles *precompile.Precompiles
 }
 
 // NewKeeper generates new evm module keeper
@@ -191,6 +195,18 @@ func (k *Keeper) WithChainID(chainId string) {
 	k.eip155ChainID = chainID
 }
 
+var _ precompile.EVMKeeper = &Keeper{}
+
+// WithPrecompiles sets the stateful precompiled contracts of the evm
+func (k *Keeper) WithPrecompiles(precompiles *precompile.Precompiles) {
+	k.precompiles = precompiles
+}
+
+// Precompiles returns the stateful precompiled contracts of the evm, nil if none are set
+func (k *Keeper) Precompiles() *precompile.Precompiles {
+	return k.precompiles
+}
+
 // ChainID returns the EIP155 chain ID for the EVM context
 func (k Keeper) ChainID() *big.Int {
 	return k.eip155ChainID
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	// applyTxTimer reports the time taken by ApplyTransaction
+	applyTxTimer = metrics.NewRegisteredTimer("evm/tx/duration", nil)
+	// applyTxGasHistogram reports the gas used by the transactions applied
+	applyTxGasHistogram = metrics.NewRegisteredHistogram("evm/tx/gas", nil, metrics.NewExpDecaySample(1028, 0.015))
+
+	// verifySigCacheHitCounter and verifySigCacheMissCounter report the lookups of the
+	// senders verified by aspects in VerifySigCache
+	verifySigCacheHitCounter  = metrics.NewRegisteredCounter("evm/verifysigcache/hit", nil)
+	verifySigCacheMissCounter = metrics.NewRegisteredCounter("evm/verifysigcache/miss", nil)
+)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	artelatype "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
 	"github.com/artela-network/aspect-core/djpm"
+	asptypes "github.com/artela-network/aspect-core/types"
 )
 
 func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error) {
@@ -58,6 +60,7 @@ func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common
 func (k *Keeper) tryAspectVerifier(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error) {
 	value, ok := k.VerifySigCache.Load(tx.Hash())
 	if ok {
+		verifySigCacheHitCounter.Inc(1)
 		retValue := value.(struct {
 			sender   common.Address
 			callData []byte
@@ -65,6 +68,7 @@ func (k *Keeper) tryAspectVerifier(ctx cosmos.Context, tx *ethereum.Transaction)
 		})
 		return retValue.sender, retValue.callData, retValue.err
 	}
+	verifySigCacheMissCounter.Inc(1)
 
 	// retrieve aspectCtx from sdk.Context
 	aspectCtx, ok := ctx.Value(artelatype.AspectContextKey).(*artelatype.AspectRuntimeContext)
@@ -72,7 +76,9 @@ func (k *Keeper) tryAspectVerifier(ctx cosmos.Context, tx *ethereum.Transaction)
 		return common.Address{}, []byte{}, errors.New("aspect transaction verification failed")
 	}
 
+	start := time.Now()
 	sender, call, err := djpm.AspectInstance().GetSenderAndCallData(aspectCtx, aspectCtx.EthBlockContext().BlockHeader().Number.Int64(), tx)
+	artelatype.UpdateJoinPointTimer(asptypes.JoinPointRunType_VerifyTx.String(), time.Since(start))
 
 	// not cache for eth_all, which hash is empty
 	if tx.Hash() != (common.Hash{}) {
@@ -0,0 +1,103 @@
+package precompile
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	Uint256, _ = abi.NewType("uint256", "", nil)
+	String, _  = abi.NewType("string", "", nil)
+	Bool, _    = abi.NewType("bool", "", nil)
+	Address, _ = abi.NewType("address", "", nil)
+	Int64, _   = abi.NewType("int64", "", nil)
+)
+
+var (
+	// revertSelector is the selector of Error(string), which prefixes the revert reasons
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	revertArgs     = abi.Arguments{{Type: String}}
+)
+
+// NewABI creates the abi of a precompiled contract from its methods and events.
+func NewABI(methods []abi.Method, events []abi.Event) abi.ABI {
+	contractABI := abi.ABI{
+		Methods: make(map[string]abi.Method, len(methods)),
+		Events:  make(map[string]abi.Event, len(events)),
+	}
+	for _, method := range methods {
+		contractABI.Methods[method.Name] = method
+	}
+	for _, event := range events {
+		contractABI.Events[event.Name] = event
+	}
+	return contractABI
+}
+
+// ParseMethod finds the method called by the input and decodes its arguments.
+func ParseMethod(contractABI abi.ABI, input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, fmt.Errorf("invalid input length %d", len(input))
+	}
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unpack %s arguments: %w", method.Name, err)
+	}
+	return method, args, nil
+}
+
+// RequiredGas looks up the gas of the method called by the input, the unknown methods
+// cost no gas as they fail to run anyway.
+func RequiredGas(contractABI abi.ABI, methodGas map[string]uint64, input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return methodGas[method.Name]
+}
+
+// EmitEvent adds the log of a precompiled contract event to the evm state, args
+// should be given in the same order as declared in the event abi.
+func (c *Context) EmitEvent(address common.Address, event abi.Event, args ...interface{}) error {
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("event %s expects %d args, got %d", event.Name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var nonIndexed []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return err
+	}
+
+	c.StateDB.AddLog(&ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(c.CosmosContext().BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The bank precompiled contract address
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+/// @dev The bank precompiled contract instance
+IBank constant BANK_CONTRACT = IBank(BANK_PRECOMPILE_ADDRESS);
+
+/// @title Bank precompiled contract
+/// @dev Exposes the balances and the transfers of the cosmos bank module.
+interface IBank {
+    /// @dev Emitted when coins are transferred through the precompiled contract.
+    event Transfer(address indexed from, address indexed to, string denom, uint256 amount);
+
+    /// @dev Returns the balance of the account in the given denom.
+    function balanceOf(address account, string calldata denom) external view returns (uint256 amount);
+
+    /// @dev Transfers coins of the given denom from the caller to the recipient.
+    function transfer(address to, string calldata denom, uint256 amount) external returns (bool success);
+}
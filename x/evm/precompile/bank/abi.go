@@ -0,0 +1,41 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+const (
+	MethodBalanceOf = "balanceOf"
+	MethodTransfer  = "transfer"
+
+	EventTransfer = "Transfer"
+)
+
+// ABI is the abi of the bank precompiled contract, see IBank.sol
+var ABI = precompile.NewABI(
+	[]abi.Method{
+		abi.NewMethod(MethodBalanceOf, MethodBalanceOf, abi.Function, "view", false, false, abi.Arguments{
+			{Name: "account", Type: precompile.Address},
+			{Name: "denom", Type: precompile.String},
+		}, abi.Arguments{
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+		abi.NewMethod(MethodTransfer, MethodTransfer, abi.Function, "nonpayable", false, false, abi.Arguments{
+			{Name: "to", Type: precompile.Address},
+			{Name: "denom", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}, abi.Arguments{
+			{Name: "success", Type: precompile.Bool},
+		}),
+	},
+	[]abi.Event{
+		abi.NewEvent(EventTransfer, EventTransfer, false, abi.Arguments{
+			{Name: "from", Type: precompile.Address, Indexed: true},
+			{Name: "to", Type: precompile.Address, Indexed: true},
+			{Name: "denom", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+	},
+)
@@ -0,0 +1,105 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+// Address is the address of the bank precompiled contract
+var Address = common.HexToAddress("0x0000000000000000000000000000000000000804")
+
+// methodGas is the gas of the methods, the gas of transfer also bounds the cosmos
+// gas consumed by the bank msg server.
+var methodGas = map[string]uint64{
+	MethodBalanceOf: 3000,
+	MethodTransfer:  50000,
+}
+
+var _ precompile.Contract = &Contract{}
+
+// Contract is the bank precompiled contract, which exposes the balances and the
+// transfers of the bank module to the evm.
+type Contract struct {
+	bankKeeper bankkeeper.Keeper
+	msgServer  banktypes.MsgServer
+}
+
+// NewContract creates a new bank precompiled contract
+func NewContract(bankKeeper bankkeeper.Keeper) *Contract {
+	return &Contract{
+		bankKeeper: bankKeeper,
+		msgServer:  bankkeeper.NewMsgServerImpl(bankKeeper),
+	}
+}
+
+func (c *Contract) Address() common.Address {
+	return Address
+}
+
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	return precompile.RequiredGas(ABI, methodGas, input)
+}
+
+func (c *Contract) Run(ctx *precompile.Context, input []byte) ([]byte, error) {
+	method, args, err := precompile.ParseMethod(ABI, input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case MethodBalanceOf:
+		return c.balanceOf(ctx, args)
+	case MethodTransfer:
+		return c.transfer(ctx, args)
+	default:
+		return nil, fmt.Errorf("method %s not found", method.Name)
+	}
+}
+
+// balanceOf returns the balance of the account in the denom, the balance in the evm denom
+// is read from the evm state as it is not flushed to the bank module before the commit.
+func (c *Contract) balanceOf(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	account, _ := args[0].(common.Address)
+	denom, _ := args[1].(string)
+
+	if denom == ctx.EVMDenom {
+		return ABI.Methods[MethodBalanceOf].Outputs.Pack(ctx.StateDB.GetBalance(account))
+	}
+	balance := c.bankKeeper.GetBalance(ctx.CosmosContext(), cosmos.AccAddress(account.Bytes()), denom)
+	return ABI.Methods[MethodBalanceOf].Outputs.Pack(balance.Amount.BigInt())
+}
+
+// transfer sends the coins of the denom from the caller to the recipient
+func (c *Contract) transfer(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	to, _ := args[0].(common.Address)
+	denom, _ := args[1].(string)
+	amount, _ := args[2].(*big.Int)
+
+	coin, err := precompile.BigIntToCoin(denom, amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := banktypes.NewMsgSend(cosmos.AccAddress(ctx.Caller.Bytes()), cosmos.AccAddress(to.Bytes()), cosmos.NewCoins(coin))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.ExecuteNativeAction(func(cosmosCtx cosmos.Context) error {
+		_, err := c.msgServer.Send(cosmos.WrapSDKContext(cosmosCtx), msg)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EmitEvent(Address, ABI.Events[EventTransfer], ctx.Caller, to, denom, amount); err != nil {
+		return nil, err
+	}
+	return ABI.Methods[MethodTransfer].Outputs.Pack(true)
+}
@@ -0,0 +1,105 @@
+package bank
+
+import (
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+	"github.com/artela-network/artela/x/evm/precompile/testutil"
+)
+
+var (
+	testSender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testRecipient = common.HexToAddress("0x1000000000000000000000000000000000000002")
+)
+
+func run(t *testing.T, contract *Contract, ctx *precompile.Context, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := ABI.Pack(method, args...)
+	require.NoError(t, err)
+	ret, err := contract.Run(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return ABI.Methods[method].Outputs.Unpack(ret)
+}
+
+func TestTransfer(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.BankKeeper)
+	keepers.Mint(t, testSender.Bytes(), testutil.Denom, 1000)
+	keepers.Mint(t, testSender.Bytes(), "uatom", 10)
+
+	ctx := keepers.NewContext(testSender)
+	ret, err := run(t, contract, ctx, MethodTransfer, testRecipient, testutil.Denom, big.NewInt(300))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, ret)
+	ret, err = run(t, contract, ctx, MethodTransfer, testRecipient, "uatom", big.NewInt(4))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, ret)
+	require.Len(t, ctx.StateDB.Logs(), 2)
+
+	// the transfers are seen by the evm and the later calls before the commit
+	require.Equal(t, big.NewInt(700), ctx.StateDB.GetBalance(testSender))
+	require.Equal(t, big.NewInt(300), ctx.StateDB.GetBalance(testRecipient))
+	ret, err = run(t, contract, ctx, MethodBalanceOf, testRecipient, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(4)}, ret)
+	require.Equal(t, big.NewInt(1000), keepers.Balance(testSender, testutil.Denom))
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(700), keepers.Balance(testSender, testutil.Denom))
+	require.Equal(t, big.NewInt(300), keepers.Balance(testRecipient, testutil.Denom))
+	require.Equal(t, big.NewInt(6), keepers.Balance(testSender, "uatom"))
+	require.Equal(t, big.NewInt(4), keepers.Balance(testRecipient, "uatom"))
+}
+
+func TestTransferFailed(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.BankKeeper)
+	keepers.Mint(t, testSender.Bytes(), testutil.Denom, 100)
+
+	ctx := keepers.NewContext(testSender)
+	_, err := run(t, contract, ctx, MethodTransfer, testRecipient, testutil.Denom, big.NewInt(101))
+	require.ErrorContains(t, err, "insufficient funds")
+
+	ctx.ReadOnly = true
+	_, err = run(t, contract, ctx, MethodTransfer, testRecipient, testutil.Denom, big.NewInt(1))
+	require.ErrorIs(t, err, precompile.ErrWriteProtection)
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(100), keepers.Balance(testSender, testutil.Denom))
+	require.Empty(t, ctx.StateDB.Logs())
+}
+
+func TestBalanceOfAfterValueTransfer(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.BankKeeper)
+	keepers.Mint(t, testSender.Bytes(), testutil.Denom, 1000)
+
+	// the value transferred by the evm is not flushed to the bank module yet
+	ctx := keepers.NewContext(testSender)
+	ctx.StateDB.SubBalance(testSender, big.NewInt(250))
+	ctx.StateDB.AddBalance(testRecipient, big.NewInt(250))
+	require.Equal(t, cosmos.NewInt(0), keepers.BankKeeper.GetBalance(keepers.Ctx, testRecipient.Bytes(), testutil.Denom).Amount)
+
+	ret, err := run(t, contract, ctx, MethodBalanceOf, testSender, testutil.Denom)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(750)}, ret)
+	ret, err = run(t, contract, ctx, MethodBalanceOf, testRecipient, testutil.Denom)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(250)}, ret)
+
+	// the transfer spends the balance left after the value transfer
+	_, err = run(t, contract, ctx, MethodTransfer, testRecipient, testutil.Denom, big.NewInt(751))
+	require.ErrorContains(t, err, "insufficient funds")
+	_, err = run(t, contract, ctx, MethodTransfer, testRecipient, testutil.Denom, big.NewInt(750))
+	require.NoError(t, err)
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(0), keepers.Balance(testSender, testutil.Denom))
+	require.Equal(t, big.NewInt(1000), keepers.Balance(testRecipient, testutil.Denom))
+}
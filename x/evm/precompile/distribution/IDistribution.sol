@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The distribution precompiled contract address
+address constant DISTRIBUTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+/// @dev The distribution precompiled contract instance
+IDistribution constant DISTRIBUTION_CONTRACT = IDistribution(DISTRIBUTION_PRECOMPILE_ADDRESS);
+
+/// @title Distribution precompiled contract
+/// @dev Exposes the staking rewards of the cosmos distribution module. The validators are
+/// given by their bech32 operator addresses, and the amounts are in the bond denom.
+interface IDistribution {
+    /// @dev Emitted when the caller withdraws the rewards of a delegation.
+    event WithdrawDelegatorRewards(address indexed delegator, string validator, uint256 amount);
+
+    /// @dev Returns the outstanding rewards of the delegation of the delegator to the validator.
+    function rewards(address delegator, string calldata validator) external view returns (uint256 amount);
+
+    /// @dev Withdraws the rewards of the delegation of the caller to the validator.
+    function withdrawDelegatorRewards(string calldata validator) external returns (uint256 amount);
+}
@@ -0,0 +1,38 @@
+package distribution
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+const (
+	MethodRewards                  = "rewards"
+	MethodWithdrawDelegatorRewards = "withdrawDelegatorRewards"
+
+	EventWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+)
+
+// ABI is the abi of the distribution precompiled contract, see IDistribution.sol
+var ABI = precompile.NewABI(
+	[]abi.Method{
+		abi.NewMethod(MethodRewards, MethodRewards, abi.Function, "view", false, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address},
+			{Name: "validator", Type: precompile.String},
+		}, abi.Arguments{
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+		abi.NewMethod(MethodWithdrawDelegatorRewards, MethodWithdrawDelegatorRewards, abi.Function, "nonpayable", false, false, abi.Arguments{
+			{Name: "validator", Type: precompile.String},
+		}, abi.Arguments{
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+	},
+	[]abi.Event{
+		abi.NewEvent(EventWithdrawDelegatorRewards, EventWithdrawDelegatorRewards, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address, Indexed: true},
+			{Name: "validator", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+	},
+)
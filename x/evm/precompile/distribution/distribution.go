@@ -0,0 +1,119 @@
+package distribution
+
+import (
+	"fmt"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+// Address is the address of the distribution precompiled contract
+var Address = common.HexToAddress("0x0000000000000000000000000000000000000801")
+
+// methodGas is the gas of the methods, the gas of withdrawDelegatorRewards also bounds
+// the cosmos gas consumed by the distribution msg server.
+var methodGas = map[string]uint64{
+	MethodRewards:                  10000,
+	MethodWithdrawDelegatorRewards: 100000,
+}
+
+// StakingKeeper defines the staking keeper methods required by the distribution precompiled contract
+type StakingKeeper interface {
+	BondDenom(ctx cosmos.Context) string
+}
+
+var _ precompile.Contract = &Contract{}
+
+// Contract is the distribution precompiled contract, which allows the evm accounts
+// to query and withdraw their staking rewards. The rewards are given in the bond denom.
+type Contract struct {
+	stakingKeeper StakingKeeper
+	querier       distrkeeper.Querier
+	msgServer     distrtypes.MsgServer
+}
+
+// NewContract creates a new distribution precompiled contract
+func NewContract(distrKeeper distrkeeper.Keeper, stakingKeeper StakingKeeper) *Contract {
+	return &Contract{
+		stakingKeeper: stakingKeeper,
+		querier:       distrkeeper.NewQuerier(distrKeeper),
+		msgServer:     distrkeeper.NewMsgServerImpl(distrKeeper),
+	}
+}
+
+func (c *Contract) Address() common.Address {
+	return Address
+}
+
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	return precompile.RequiredGas(ABI, methodGas, input)
+}
+
+func (c *Contract) Run(ctx *precompile.Context, input []byte) ([]byte, error) {
+	method, args, err := precompile.ParseMethod(ABI, input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case MethodRewards:
+		return c.rewards(ctx, args)
+	case MethodWithdrawDelegatorRewards:
+		return c.withdrawDelegatorRewards(ctx, args)
+	default:
+		return nil, fmt.Errorf("method %s not found", method.Name)
+	}
+}
+
+// rewards returns the outstanding rewards of the delegation
+func (c *Contract) rewards(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	delegator, _ := args[0].(common.Address)
+	validator, _ := args[1].(string)
+
+	cosmosCtx := ctx.CosmosContext()
+	res, err := c.querier.DelegationRewards(cosmos.WrapSDKContext(cosmosCtx), &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: cosmos.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount := res.Rewards.AmountOf(c.stakingKeeper.BondDenom(cosmosCtx)).TruncateInt()
+	return ABI.Methods[MethodRewards].Outputs.Pack(amount.BigInt())
+}
+
+// withdrawDelegatorRewards withdraws the rewards of the delegation of the caller to the validator
+func (c *Contract) withdrawDelegatorRewards(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	validator, _ := args[0].(string)
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: cosmos.AccAddress(ctx.Caller.Bytes()).String(),
+		ValidatorAddress: validator,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var withdrawn cosmos.Coins
+	if err := ctx.ExecuteNativeAction(func(cosmosCtx cosmos.Context) error {
+		res, err := c.msgServer.WithdrawDelegatorReward(cosmos.WrapSDKContext(cosmosCtx), msg)
+		if err != nil {
+			return err
+		}
+		withdrawn = res.Amount
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	amount := withdrawn.AmountOf(c.stakingKeeper.BondDenom(ctx.CosmosContext())).BigInt()
+	if err := ctx.EmitEvent(Address, ABI.Events[EventWithdrawDelegatorRewards], ctx.Caller, validator, amount); err != nil {
+		return nil, err
+	}
+	return ABI.Methods[MethodWithdrawDelegatorRewards].Outputs.Pack(amount)
+}
@@ -0,0 +1,98 @@
+package distribution
+
+import (
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+	"github.com/artela-network/artela/x/evm/precompile/testutil"
+)
+
+var testDelegator = common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+func run(t *testing.T, contract *Contract, ctx *precompile.Context, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := ABI.Pack(method, args...)
+	require.NoError(t, err)
+	ret, err := contract.Run(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return ABI.Methods[method].Outputs.Unpack(ret)
+}
+
+// delegateWithRewards delegates to a new validator with the same self delegation, and allocates
+// the rewards to the validator, half of which are given to the delegator.
+func delegateWithRewards(t *testing.T, keepers *testutil.Keepers, rewards int64) cosmos.ValAddress {
+	valAddr := keepers.CreateValidator(t, 1000000)
+	keepers.Mint(t, testDelegator.Bytes(), testutil.Denom, 1000000)
+	_, err := stakingkeeper.NewMsgServerImpl(keepers.StakingKeeper).Delegate(cosmos.WrapSDKContext(keepers.Ctx), &stakingtypes.MsgDelegate{
+		DelegatorAddress: cosmos.AccAddress(testDelegator.Bytes()).String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           cosmos.NewInt64Coin(testutil.Denom, 1000000),
+	})
+	require.NoError(t, err)
+
+	// the delegations earn no rewards in the block they start
+	keepers.Ctx = keepers.Ctx.WithBlockHeight(keepers.Ctx.BlockHeight() + 1)
+	coins := cosmos.NewCoins(cosmos.NewInt64Coin(testutil.Denom, rewards))
+	require.NoError(t, keepers.BankKeeper.MintCoins(keepers.Ctx, minttypes.ModuleName, coins))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToModule(keepers.Ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+	validator, found := keepers.StakingKeeper.GetValidator(keepers.Ctx, valAddr)
+	require.True(t, found)
+	keepers.DistrKeeper.AllocateTokensToValidator(keepers.Ctx, validator, cosmos.NewDecCoinsFromCoins(coins...))
+	return valAddr
+}
+
+func TestWithdrawDelegatorRewards(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.DistrKeeper, keepers.StakingKeeper)
+	validator := delegateWithRewards(t, keepers, 1000).String()
+
+	ctx := keepers.NewContext(testDelegator)
+	ret, err := run(t, contract, ctx, MethodRewards, testDelegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(500)}, ret)
+
+	ret, err = run(t, contract, ctx, MethodWithdrawDelegatorRewards, validator)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(500)}, ret)
+	require.Len(t, ctx.StateDB.Logs(), 1)
+
+	// the withdrawn rewards are seen by the evm before the commit
+	require.Equal(t, big.NewInt(500), ctx.StateDB.GetBalance(testDelegator))
+	ret, err = run(t, contract, ctx, MethodRewards, testDelegator, validator)
+	require.NoError(t, err)
+	require.Zero(t, ret[0].(*big.Int).Sign())
+	require.Equal(t, big.NewInt(0), keepers.Balance(testDelegator, testutil.Denom))
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(500), keepers.Balance(testDelegator, testutil.Denom))
+}
+
+func TestWithdrawDelegatorRewardsFailed(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.DistrKeeper, keepers.StakingKeeper)
+	validator := delegateWithRewards(t, keepers, 1000).String()
+
+	// the caller has no delegation to the validator
+	ctx := keepers.NewContext(common.HexToAddress("0x1000000000000000000000000000000000000002"))
+	_, err := run(t, contract, ctx, MethodWithdrawDelegatorRewards, validator)
+	require.Error(t, err)
+
+	ctx = keepers.NewContext(testDelegator)
+	ctx.ReadOnly = true
+	_, err = run(t, contract, ctx, MethodWithdrawDelegatorRewards, validator)
+	require.ErrorIs(t, err, precompile.ErrWriteProtection)
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(0), keepers.Balance(testDelegator, testutil.Denom))
+	require.Empty(t, ctx.StateDB.Logs())
+}
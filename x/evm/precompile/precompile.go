@@ -0,0 +1,250 @@
+package precompile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela-evm/vm"
+	artvmtypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+var (
+	// ErrWriteProtection is returned if a state-changing method is invoked in a
+	// static call, delegate call or call code.
+	ErrWriteProtection = errors.New("write protection")
+	// ErrNonPayable is returned if the precompiled contract is called with value.
+	ErrNonPayable = errors.New("precompiled contract is not payable")
+)
+
+// installMux guards the installation of the dispatchers into the precompiled contracts of the evm.
+var installMux sync.Mutex
+
+// Contract defines a stateful precompiled contract, which gives the evm access to
+// the cosmos modules.
+type Contract interface {
+	// Address returns the address the contract is precompiled at.
+	Address() common.Address
+	// RequiredGas returns the gas required to run the contract with the given input.
+	RequiredGas(input []byte) uint64
+	// Run executes the contract. Errors returned by Run revert the call, with the
+	// error message as the revert reason.
+	Run(ctx *Context, input []byte) ([]byte, error)
+}
+
+// EVMKeeper defines the evm keeper methods required by the precompiled contracts, it is
+// the keeper of the statedb of the running evm.
+type EVMKeeper interface {
+	GetParams(ctx cosmos.Context) support.Params
+	Precompiles() *Precompiles
+}
+
+// UpgradeKeeper defines the upgrade keeper methods required to enable the precompiled contracts.
+type UpgradeKeeper interface {
+	GetDoneHeight(ctx cosmos.Context, name string) int64
+}
+
+// Context is the execution context of a stateful precompiled contract.
+type Context struct {
+	// Caller is the address calling the contract.
+	Caller common.Address
+	// ReadOnly is true if the contract is not invoked by a plain CALL, i.e. in a static
+	// call, delegate call or call code, in which case the state must not be modified.
+	ReadOnly bool
+	// StateDB is the state of the running evm.
+	StateDB *states.StateDB
+	// EVM is the running evm.
+	EVM *vm.EVM
+	// GasMeter meters the cosmos gas consumed by the native actions, it is limited to
+	// the gas charged for the call as the evm gas cannot be charged afterwards.
+	GasMeter storetypes.GasMeter
+	// EVMDenom is the denom of the evm balances, which are read from the StateDB.
+	EVMDenom string
+}
+
+// CosmosContext returns the cosmos context reflecting the current evm state.
+func (c *Context) CosmosContext() cosmos.Context {
+	return c.StateDB.Context()
+}
+
+// ExecuteNativeAction runs a state-changing cosmos action, the changes made by the action
+// are reverted along with the evm state. The action fails if it consumes more cosmos
+// gas than left in the gas meter of the context.
+func (c *Context) ExecuteNativeAction(action func(ctx cosmos.Context) error) error {
+	if c.ReadOnly {
+		return ErrWriteProtection
+	}
+	if c.GasMeter == nil {
+		return errors.New("precompiled contract has no gas meter")
+	}
+	return c.StateDB.ExecuteNativeAction(func(ctx cosmos.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+				err = fmt.Errorf("out of gas in %s", outOfGas.Descriptor)
+			}
+		}()
+		return action(ctx.WithGasMeter(c.GasMeter))
+	})
+}
+
+// Precompiles holds the stateful precompiled contracts of an evm keeper. The contracts are
+// enabled once the upgrade is done, and their addresses are listed in the active precompiles
+// of the evm params.
+type Precompiles struct {
+	upgradeKeeper UpgradeKeeper
+	upgradeName   string
+	contracts     map[common.Address]Contract
+}
+
+// NewPrecompiles creates the stateful precompiled contracts enabled by the upgrade.
+func NewPrecompiles(upgradeKeeper UpgradeKeeper, upgradeName string, contracts ...Contract) *Precompiles {
+	p := &Precompiles{
+		upgradeKeeper: upgradeKeeper,
+		upgradeName:   upgradeName,
+		contracts:     make(map[common.Address]Contract, len(contracts)),
+	}
+	for _, contract := range contracts {
+		p.contracts[contract.Address()] = contract
+		install(contract)
+	}
+	return p
+}
+
+// install adds a dispatcher of the contract to the precompiled contracts of the evm. The evm
+// only looks up the precompiled contracts in its global tables, so the dispatcher holds no
+// state of the app and runs the contract of the running evm keeper only if it is active.
+func install(contract Contract) {
+	installMux.Lock()
+	defer installMux.Unlock()
+
+	address := contract.Address()
+	if _, ok := vm.PrecompiledContractsBerlin[address]; ok {
+		return
+	}
+	vm.PrecompiledAddressesBerlin = append(vm.PrecompiledAddressesBerlin, address)
+	vm.PrecompiledContractsBerlin[address] = &dispatcher{
+		address:     address,
+		requiredGas: contract.RequiredGas,
+	}
+}
+
+// contract returns the contract at the address if it is active at the height of the context.
+func (p *Precompiles) contract(ctx cosmos.Context, evmParams support.Params, address common.Address) (Contract, bool) {
+	if p == nil {
+		return nil, false
+	}
+	contract, ok := p.contracts[address]
+	if !ok || !evmParams.IsActivePrecompile(address) {
+		return nil, false
+	}
+	// the lookup is not charged, the gas used before the upgrade is kept unchanged
+	if p.upgradeKeeper.GetDoneHeight(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), p.upgradeName) == 0 {
+		return nil, false
+	}
+	return contract, true
+}
+
+// ActivePrecompiles returns the addresses of the precompiled contracts enabled under the chain
+// rules, the evm params and the upgrade, which are warm in the access list of a txs.
+func (p *Precompiles) ActivePrecompiles(ctx cosmos.Context, rules params.Rules, evmParams support.Params) []common.Address {
+	precompiles := vm.ActivePrecompiles(rules)
+	active := make([]common.Address, 0, len(precompiles))
+	for _, address := range precompiles {
+		if _, ok := vm.PrecompiledContractsBerlin[address].(*dispatcher); ok {
+			if _, ok := p.contract(ctx, evmParams, address); !ok {
+				continue
+			}
+		}
+		active = append(active, address)
+	}
+	return active
+}
+
+// dispatcher adapts the contracts at an address to the vm.PrecompiledContract interface.
+type dispatcher struct {
+	address     common.Address
+	requiredGas func(input []byte) uint64
+}
+
+func (d *dispatcher) RequiredGas(input []byte) uint64 {
+	return d.requiredGas(input)
+}
+
+func (d *dispatcher) Run(ctx context.Context, input []byte) ([]byte, error) {
+	aspectCtx, ok := ctx.(*artvmtypes.AspectRuntimeContext)
+	if !ok || aspectCtx.EthTxContext() == nil || aspectCtx.EthTxContext().LastEvm() == nil {
+		return nil, fmt.Errorf("invalid precompile context %T", ctx)
+	}
+	evm := aspectCtx.EthTxContext().LastEvm()
+	stateDB, ok := evm.StateDB.(*states.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("invalid precompile state %T", evm.StateDB)
+	}
+
+	// an inactive contract behaves as an account without code, though the gas of the
+	// method is still charged since the evm looks it up before running the contract.
+	keeper, ok := stateDB.Keeper().(EVMKeeper)
+	if !ok {
+		return nil, nil
+	}
+	evmParams := keeper.GetParams(stateDB.Context())
+	contract, ok := keeper.Precompiles().contract(stateDB.Context(), evmParams, d.address)
+	if !ok {
+		return nil, nil
+	}
+
+	// only CALL pushes a frame to the call tree, so the current frame belongs to
+	// another contract if the precompile is invoked by any other opcode.
+	call := evm.Tracer().CallTree().Current()
+	readOnly := call == nil || call.To == nil || *call.To != d.address
+	if !readOnly && call.Value != nil && !call.Value.IsZero() {
+		return revert(ErrNonPayable)
+	}
+
+	runCtx := &Context{
+		ReadOnly: readOnly,
+		StateDB:  stateDB,
+		EVM:      evm,
+		GasMeter: storetypes.NewGasMeter(contract.RequiredGas(input)),
+		EVMDenom: evmParams.EvmDenom,
+	}
+	if !readOnly {
+		runCtx.Caller = call.From
+	}
+
+	ret, err := contract.Run(runCtx, input)
+	if err != nil {
+		return revert(err)
+	}
+	return ret, nil
+}
+
+// revert returns the abi encoded revert reason of the error.
+func revert(err error) ([]byte, error) {
+	reason, packErr := revertArgs.Pack(err.Error())
+	if packErr != nil {
+		return nil, packErr
+	}
+	return append(common.CopyBytes(revertSelector), reason...), vm.ErrExecutionReverted
+}
+
+// BigIntToCoin converts an evm amount to the cosmos coin of the denom.
+func BigIntToCoin(denom string, amount *big.Int) (cosmos.Coin, error) {
+	if amount == nil || amount.Sign() < 0 {
+		return cosmos.Coin{}, fmt.Errorf("invalid amount %s", amount)
+	}
+	return cosmos.NewCoin(denom, cosmos.NewIntFromBigInt(amount)), nil
+}
@@ -0,0 +1,170 @@
+package precompile
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+var (
+	testAddress = common.HexToAddress("0x0000000000000000000000000000000000009999")
+	testAccount = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testKey     = []byte("native")
+)
+
+type testContract struct{}
+
+func (testContract) Address() common.Address              { return testAddress }
+func (testContract) RequiredGas([]byte) uint64            { return 0 }
+func (testContract) Run(*Context, []byte) ([]byte, error) { return nil, nil }
+
+// storeKeeper keeps the balances of the accounts in the store, so that the writes
+// of the statedb are branched along with the native actions.
+type storeKeeper struct {
+	key storetypes.StoreKey
+}
+
+func (k storeKeeper) GetAccount(ctx cosmos.Context, addr common.Address) *states.StateAccount {
+	bz := ctx.KVStore(k.key).Get(addr.Bytes())
+	if bz == nil {
+		return nil
+	}
+	account := states.NewEmptyAccount()
+	account.Balance.SetBytes(bz)
+	return account
+}
+
+func (k storeKeeper) SetAccount(ctx cosmos.Context, addr common.Address, account states.StateAccount) error {
+	ctx.KVStore(k.key).Set(addr.Bytes(), account.Balance.Bytes())
+	return nil
+}
+
+func (k storeKeeper) DeleteAccount(ctx cosmos.Context, addr common.Address) error {
+	ctx.KVStore(k.key).Delete(addr.Bytes())
+	return nil
+}
+
+func (storeKeeper) GetState(cosmos.Context, common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (storeKeeper) GetCode(cosmos.Context, common.Hash) []byte { return nil }
+func (storeKeeper) ForEachStorage(cosmos.Context, common.Address, func(key, value common.Hash) bool) {
+}
+func (storeKeeper) SetState(cosmos.Context, common.Address, common.Hash, []byte) {}
+func (storeKeeper) SetCode(cosmos.Context, []byte, []byte)                       {}
+
+type testUpgradeKeeper struct {
+	doneHeight int64
+}
+
+func (uk testUpgradeKeeper) GetDoneHeight(cosmos.Context, string) int64 { return uk.doneHeight }
+
+func TestActivePrecompiles(t *testing.T) {
+	rules := params.Rules{IsBerlin: true}
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	evmParams := support.DefaultParams()
+	evmParams.ActivePrecompiles = []string{testAddress.Hex()}
+
+	// the contracts are inactive before the upgrade, even if listed in the params
+	precompiles := NewPrecompiles(testUpgradeKeeper{}, "test", testContract{})
+	inactive := precompiles.ActivePrecompiles(ctx, rules, evmParams)
+	require.NotContains(t, inactive, testAddress)
+	require.Contains(t, inactive, common.BytesToAddress([]byte{1}))
+	require.Len(t, inactive, len(vm.ActivePrecompiles(rules))-1)
+
+	// the contracts are enabled by the upgrade and the params
+	precompiles = NewPrecompiles(testUpgradeKeeper{doneHeight: 1}, "test", testContract{})
+	require.NotContains(t, precompiles.ActivePrecompiles(ctx, rules, support.DefaultParams()), testAddress)
+	require.Contains(t, precompiles.ActivePrecompiles(ctx, rules, evmParams), testAddress)
+
+	// the keepers without contracts keep the precompiles of the evm only
+	var none *Precompiles
+	require.NotContains(t, none.ActivePrecompiles(ctx, rules, evmParams), testAddress)
+}
+
+func TestRevert(t *testing.T) {
+	ret, err := revert(errors.New("insufficient funds"))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+	reason, err := abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	require.Equal(t, "insufficient funds", reason)
+}
+
+func TestExecuteNativeAction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		readOnly   bool
+		gas        uint64
+		actionErr  error
+		revert     bool
+		expErr     error
+		expBalance int64
+	}{
+		{"success, changes committed", false, 10000, nil, false, nil, 7},
+		{"success, changes reverted with the evm states", false, 10000, nil, true, nil, 0},
+		{"fail, write protection", true, 10000, nil, false, ErrWriteProtection, 0},
+		{"fail, action failed", false, 10000, errors.New("action failed"), false, errors.New("action failed"), 0},
+		{"fail, out of gas", false, 100, nil, false, errors.New("out of gas in WriteFlat"), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey("test")
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			keeper := storeKeeper{key: key}
+			stateDB := states.New(ctx, keeper, states.NewEmptyTxConfig(common.Hash{}))
+
+			// the dirty balance is flushed before the action, and reverted along with it
+			stateDB.AddBalance(testAccount, big.NewInt(5))
+			snapshot := stateDB.Snapshot()
+
+			runCtx := &Context{
+				ReadOnly: tc.readOnly,
+				StateDB:  stateDB,
+				GasMeter: storetypes.NewGasMeter(tc.gas),
+			}
+			err := runCtx.ExecuteNativeAction(func(ctx cosmos.Context) error {
+				ctx.KVStore(key).Set(testKey, []byte{1})
+				ctx.KVStore(key).Set(testAccount.Bytes(), big.NewInt(7).Bytes())
+				return tc.actionErr
+			})
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if err == nil {
+				// the balance changed by the action is loaded back into the evm state
+				require.Equal(t, big.NewInt(7), stateDB.GetBalance(testAccount))
+			}
+			if tc.revert {
+				stateDB.RevertToSnapshot(snapshot)
+				require.Equal(t, big.NewInt(5), stateDB.GetBalance(testAccount))
+			}
+			// nothing is written to the parent context before the commit
+			require.Nil(t, ctx.KVStore(key).Get(testKey))
+
+			require.NoError(t, stateDB.Commit())
+			if tc.expBalance == 0 {
+				require.Nil(t, ctx.KVStore(key).Get(testKey))
+				return
+			}
+			require.Equal(t, []byte{1}, ctx.KVStore(key).Get(testKey))
+			require.Equal(t, big.NewInt(tc.expBalance).Bytes(), ctx.KVStore(key).Get(testAccount.Bytes()))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The staking precompiled contract address
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000800;
+
+/// @dev The staking precompiled contract instance
+IStaking constant STAKING_CONTRACT = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+/// @title Staking precompiled contract
+/// @dev Exposes the delegations of the cosmos staking module. The validators are given
+/// by their bech32 operator addresses, and the amounts are in the bond denom.
+interface IStaking {
+    /// @dev Emitted when the caller delegates to a validator.
+    event Delegate(address indexed delegator, string validator, uint256 amount);
+
+    /// @dev Emitted when the caller undelegates from a validator.
+    event Undelegate(address indexed delegator, string validator, uint256 amount, int64 completionTime);
+
+    /// @dev Emitted when the caller redelegates from one validator to another.
+    event Redelegate(
+        address indexed delegator,
+        string validatorSrc,
+        string validatorDst,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    /// @dev Returns the amount of tokens delegated by the delegator to the validator.
+    function delegation(address delegator, string calldata validator) external view returns (uint256 amount);
+
+    /// @dev Delegates the tokens of the caller to the validator.
+    function delegate(string calldata validator, uint256 amount) external returns (bool success);
+
+    /// @dev Undelegates the tokens of the caller from the validator, returns the unix time
+    /// the unbonding completes at.
+    function undelegate(string calldata validator, uint256 amount) external returns (int64 completionTime);
+
+    /// @dev Redelegates the tokens of the caller from the source validator to the destination
+    /// validator, returns the unix time the redelegation completes at.
+    function redelegate(
+        string calldata validatorSrc,
+        string calldata validatorDst,
+        uint256 amount
+    ) external returns (int64 completionTime);
+}
@@ -0,0 +1,69 @@
+package staking
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+const (
+	MethodDelegation = "delegation"
+	MethodDelegate   = "delegate"
+	MethodUndelegate = "undelegate"
+	MethodRedelegate = "redelegate"
+
+	EventDelegate   = "Delegate"
+	EventUndelegate = "Undelegate"
+	EventRedelegate = "Redelegate"
+)
+
+// ABI is the abi of the staking precompiled contract, see IStaking.sol
+var ABI = precompile.NewABI(
+	[]abi.Method{
+		abi.NewMethod(MethodDelegation, MethodDelegation, abi.Function, "view", false, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address},
+			{Name: "validator", Type: precompile.String},
+		}, abi.Arguments{
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+		abi.NewMethod(MethodDelegate, MethodDelegate, abi.Function, "nonpayable", false, false, abi.Arguments{
+			{Name: "validator", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}, abi.Arguments{
+			{Name: "success", Type: precompile.Bool},
+		}),
+		abi.NewMethod(MethodUndelegate, MethodUndelegate, abi.Function, "nonpayable", false, false, abi.Arguments{
+			{Name: "validator", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}, abi.Arguments{
+			{Name: "completionTime", Type: precompile.Int64},
+		}),
+		abi.NewMethod(MethodRedelegate, MethodRedelegate, abi.Function, "nonpayable", false, false, abi.Arguments{
+			{Name: "validatorSrc", Type: precompile.String},
+			{Name: "validatorDst", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}, abi.Arguments{
+			{Name: "completionTime", Type: precompile.Int64},
+		}),
+	},
+	[]abi.Event{
+		abi.NewEvent(EventDelegate, EventDelegate, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address, Indexed: true},
+			{Name: "validator", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+		}),
+		abi.NewEvent(EventUndelegate, EventUndelegate, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address, Indexed: true},
+			{Name: "validator", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+			{Name: "completionTime", Type: precompile.Int64},
+		}),
+		abi.NewEvent(EventRedelegate, EventRedelegate, false, abi.Arguments{
+			{Name: "delegator", Type: precompile.Address, Indexed: true},
+			{Name: "validatorSrc", Type: precompile.String},
+			{Name: "validatorDst", Type: precompile.String},
+			{Name: "amount", Type: precompile.Uint256},
+			{Name: "completionTime", Type: precompile.Int64},
+		}),
+	},
+)
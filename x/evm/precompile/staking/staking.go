@@ -0,0 +1,200 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+)
+
+// Address is the address of the staking precompiled contract
+var Address = common.HexToAddress("0x0000000000000000000000000000000000000800")
+
+// methodGas is the gas of the methods, the gas of the state-changing methods also
+// bounds the cosmos gas consumed by the staking msg server.
+var methodGas = map[string]uint64{
+	MethodDelegation: 3000,
+	MethodDelegate:   150000,
+	MethodUndelegate: 150000,
+	MethodRedelegate: 200000,
+}
+
+var _ precompile.Contract = &Contract{}
+
+// Contract is the staking precompiled contract, which allows the evm accounts to
+// delegate, undelegate and redelegate their staking tokens.
+type Contract struct {
+	stakingKeeper *stakingkeeper.Keeper
+	msgServer     stakingtypes.MsgServer
+}
+
+// NewContract creates a new staking precompiled contract
+func NewContract(stakingKeeper *stakingkeeper.Keeper) *Contract {
+	return &Contract{
+		stakingKeeper: stakingKeeper,
+		msgServer:     stakingkeeper.NewMsgServerImpl(stakingKeeper),
+	}
+}
+
+func (c *Contract) Address() common.Address {
+	return Address
+}
+
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	return precompile.RequiredGas(ABI, methodGas, input)
+}
+
+func (c *Contract) Run(ctx *precompile.Context, input []byte) ([]byte, error) {
+	method, args, err := precompile.ParseMethod(ABI, input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case MethodDelegation:
+		return c.delegation(ctx, args)
+	case MethodDelegate:
+		return c.delegate(ctx, args)
+	case MethodUndelegate:
+		return c.undelegate(ctx, args)
+	case MethodRedelegate:
+		return c.redelegate(ctx, args)
+	default:
+		return nil, fmt.Errorf("method %s not found", method.Name)
+	}
+}
+
+// delegation returns the amount of the tokens delegated by the delegator to the validator
+func (c *Contract) delegation(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	delegator, _ := args[0].(common.Address)
+	validator, _ := args[1].(string)
+
+	valAddr, err := cosmos.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, err
+	}
+
+	cosmosCtx := ctx.CosmosContext()
+	amount := new(big.Int)
+	if delegation, found := c.stakingKeeper.GetDelegation(cosmosCtx, cosmos.AccAddress(delegator.Bytes()), valAddr); found {
+		if val, found := c.stakingKeeper.GetValidator(cosmosCtx, valAddr); found {
+			amount = val.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+		}
+	}
+	return ABI.Methods[MethodDelegation].Outputs.Pack(amount)
+}
+
+// delegate delegates the tokens of the caller to the validator
+func (c *Contract) delegate(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	validator, _ := args[0].(string)
+	amount, _ := args[1].(*big.Int)
+
+	coin, err := c.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: cosmos.AccAddress(ctx.Caller.Bytes()).String(),
+		ValidatorAddress: validator,
+		Amount:           coin,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.ExecuteNativeAction(func(cosmosCtx cosmos.Context) error {
+		_, err := c.msgServer.Delegate(cosmos.WrapSDKContext(cosmosCtx), msg)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EmitEvent(Address, ABI.Events[EventDelegate], ctx.Caller, validator, amount); err != nil {
+		return nil, err
+	}
+	return ABI.Methods[MethodDelegate].Outputs.Pack(true)
+}
+
+// undelegate starts unbonding the tokens delegated by the caller to the validator
+func (c *Contract) undelegate(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	validator, _ := args[0].(string)
+	amount, _ := args[1].(*big.Int)
+
+	coin, err := c.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: cosmos.AccAddress(ctx.Caller.Bytes()).String(),
+		ValidatorAddress: validator,
+		Amount:           coin,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var completionTime int64
+	if err := ctx.ExecuteNativeAction(func(cosmosCtx cosmos.Context) error {
+		res, err := c.msgServer.Undelegate(cosmos.WrapSDKContext(cosmosCtx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = res.CompletionTime.Unix()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EmitEvent(Address, ABI.Events[EventUndelegate], ctx.Caller, validator, amount, completionTime); err != nil {
+		return nil, err
+	}
+	return ABI.Methods[MethodUndelegate].Outputs.Pack(completionTime)
+}
+
+// redelegate moves the tokens delegated by the caller from the source validator to the destination one
+func (c *Contract) redelegate(ctx *precompile.Context, args []interface{}) ([]byte, error) {
+	validatorSrc, _ := args[0].(string)
+	validatorDst, _ := args[1].(string)
+	amount, _ := args[2].(*big.Int)
+
+	coin, err := c.bondCoin(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    cosmos.AccAddress(ctx.Caller.Bytes()).String(),
+		ValidatorSrcAddress: validatorSrc,
+		ValidatorDstAddress: validatorDst,
+		Amount:              coin,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var completionTime int64
+	if err := ctx.ExecuteNativeAction(func(cosmosCtx cosmos.Context) error {
+		res, err := c.msgServer.BeginRedelegate(cosmos.WrapSDKContext(cosmosCtx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = res.CompletionTime.Unix()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EmitEvent(Address, ABI.Events[EventRedelegate], ctx.Caller, validatorSrc, validatorDst, amount, completionTime); err != nil {
+		return nil, err
+	}
+	return ABI.Methods[MethodRedelegate].Outputs.Pack(completionTime)
+}
+
+// bondCoin converts the amount to the coin of the bond denom
+func (c *Contract) bondCoin(ctx *precompile.Context, amount *big.Int) (cosmos.Coin, error) {
+	return precompile.BigIntToCoin(c.stakingKeeper.BondDenom(ctx.CosmosContext()), amount)
+}
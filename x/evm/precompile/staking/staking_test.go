@@ -0,0 +1,99 @@
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+	"github.com/artela-network/artela/x/evm/precompile/testutil"
+)
+
+var testDelegator = common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+func run(t *testing.T, contract *Contract, ctx *precompile.Context, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := ABI.Pack(method, args...)
+	require.NoError(t, err)
+	ret, err := contract.Run(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return ABI.Methods[method].Outputs.Unpack(ret)
+}
+
+func TestDelegate(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.StakingKeeper)
+	validator := keepers.CreateValidator(t, 1000000).String()
+	keepers.Mint(t, testDelegator.Bytes(), testutil.Denom, 5000000)
+
+	ctx := keepers.NewContext(testDelegator)
+	ret, err := run(t, contract, ctx, MethodDelegate, validator, big.NewInt(2000000))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, ret)
+	require.Len(t, ctx.StateDB.Logs(), 1)
+
+	// the delegation and the spent balance are seen before the commit
+	ret, err = run(t, contract, ctx, MethodDelegation, testDelegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(2000000)}, ret)
+	require.Equal(t, big.NewInt(3000000), ctx.StateDB.GetBalance(testDelegator))
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(3000000), keepers.Balance(testDelegator, testutil.Denom))
+}
+
+func TestUndelegateAndRedelegate(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.StakingKeeper)
+	validatorSrc := keepers.CreateValidator(t, 1000000).String()
+	validatorDst := keepers.CreateValidator(t, 1000000).String()
+	keepers.Mint(t, testDelegator.Bytes(), testutil.Denom, 5000000)
+
+	ctx := keepers.NewContext(testDelegator)
+	_, err := run(t, contract, ctx, MethodDelegate, validatorSrc, big.NewInt(3000000))
+	require.NoError(t, err)
+
+	unbondingTime := keepers.StakingKeeper.UnbondingTime(keepers.Ctx)
+	completionTime := keepers.Ctx.BlockTime().Add(unbondingTime).Unix()
+	ret, err := run(t, contract, ctx, MethodUndelegate, validatorSrc, big.NewInt(1000000))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{completionTime}, ret)
+	ret, err = run(t, contract, ctx, MethodRedelegate, validatorSrc, validatorDst, big.NewInt(500000))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{completionTime}, ret)
+	require.Len(t, ctx.StateDB.Logs(), 3)
+
+	require.NoError(t, ctx.StateDB.Commit())
+	ret, err = run(t, contract, keepers.NewContext(testDelegator), MethodDelegation, testDelegator, validatorSrc)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(1500000)}, ret)
+	ret, err = run(t, contract, keepers.NewContext(testDelegator), MethodDelegation, testDelegator, validatorDst)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(500000)}, ret)
+	// the undelegated tokens are locked until the unbonding completes
+	require.Equal(t, big.NewInt(2000000), keepers.Balance(testDelegator, testutil.Denom))
+}
+
+func TestDelegateFailed(t *testing.T) {
+	keepers := testutil.NewKeepers(t)
+	contract := NewContract(keepers.StakingKeeper)
+	validator := keepers.CreateValidator(t, 1000000).String()
+	keepers.Mint(t, testDelegator.Bytes(), testutil.Denom, 1000)
+
+	ctx := keepers.NewContext(testDelegator)
+	_, err := run(t, contract, ctx, MethodDelegate, validator, big.NewInt(1001))
+	require.ErrorContains(t, err, "insufficient funds")
+	_, err = run(t, contract, ctx, MethodDelegate, "invalid", big.NewInt(1))
+	require.Error(t, err)
+
+	ctx.ReadOnly = true
+	_, err = run(t, contract, ctx, MethodDelegate, validator, big.NewInt(1))
+	require.ErrorIs(t, err, precompile.ErrWriteProtection)
+
+	require.NoError(t, ctx.StateDB.Commit())
+	require.Equal(t, big.NewInt(1000), keepers.Balance(testDelegator, testutil.Denom))
+	require.Empty(t, ctx.StateDB.Logs())
+}
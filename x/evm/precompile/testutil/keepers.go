@@ -0,0 +1,183 @@
+package testutil
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/precompile"
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+// Denom is the evm and the bond denom of the test keepers.
+const Denom = "aart"
+
+// Keepers holds the cosmos keepers called by the precompiled contracts, over an in-memory store.
+type Keepers struct {
+	Ctx           cosmos.Context
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+	StakingKeeper *stakingkeeper.Keeper
+	DistrKeeper   distrkeeper.Keeper
+}
+
+// NewKeepers creates the auth, bank, staking and distribution keepers with their default params,
+// the staking and the distribution rewards are in the Denom.
+func NewKeepers(t *testing.T) *Keepers {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	distrtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	keys := cosmos.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, distrtypes.StoreKey)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := cosmos.NewContext(cms, tmproto.Header{Height: 1, Time: time.Unix(1700000000, 0)}, false, log.NewNopLogger())
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	accountKeeper := authkeeper.NewAccountKeeper(cdc, keys[authtypes.StoreKey], authtypes.ProtoBaseAccount, map[string][]string{
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		distrtypes.ModuleName:          nil,
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}, cosmos.GetConfig().GetBech32AccountAddrPrefix(), authority)
+	bankKeeper := bankkeeper.NewBaseKeeper(cdc, keys[banktypes.StoreKey], accountKeeper, nil, authority)
+	stakingKeeper := stakingkeeper.NewKeeper(cdc, keys[stakingtypes.StoreKey], accountKeeper, bankKeeper, authority)
+	distrKeeper := distrkeeper.NewKeeper(cdc, keys[distrtypes.StoreKey], accountKeeper, bankKeeper, stakingKeeper, authtypes.FeeCollectorName, authority)
+	stakingKeeper.SetHooks(distrKeeper.Hooks())
+
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	require.NoError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = Denom
+	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
+	require.NoError(t, distrKeeper.SetParams(ctx, distrtypes.DefaultParams()))
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
+	return &Keepers{
+		Ctx:           ctx,
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		StakingKeeper: stakingKeeper,
+		DistrKeeper:   distrKeeper,
+	}
+}
+
+// Mint mints the coins of the denom to the account.
+func (k *Keepers) Mint(t *testing.T, addr cosmos.AccAddress, denom string, amount int64) {
+	coins := cosmos.NewCoins(cosmos.NewInt64Coin(denom, amount))
+	require.NoError(t, k.BankKeeper.MintCoins(k.Ctx, minttypes.ModuleName, coins))
+	require.NoError(t, k.BankKeeper.SendCoinsFromModuleToAccount(k.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+// Balance returns the balance of the account in the denom.
+func (k *Keepers) Balance(addr common.Address, denom string) *big.Int {
+	return k.BankKeeper.GetBalance(k.Ctx, cosmos.AccAddress(addr.Bytes()), denom).Amount.BigInt()
+}
+
+// CreateValidator creates a bonded validator without commission, self delegating the tokens.
+func (k *Keepers) CreateValidator(t *testing.T, tokens int64) cosmos.ValAddress {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	operator := cosmos.AccAddress(pubKey.Address())
+	k.Mint(t, operator, Denom, tokens)
+
+	msg, err := stakingtypes.NewMsgCreateValidator(cosmos.ValAddress(operator), pubKey, cosmos.NewInt64Coin(Denom, tokens),
+		stakingtypes.Description{Moniker: "test"}, stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt())
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(k.StakingKeeper).CreateValidator(cosmos.WrapSDKContext(k.Ctx), msg)
+	require.NoError(t, err)
+	_, err = k.StakingKeeper.ApplyAndReturnValidatorSetUpdates(k.Ctx)
+	require.NoError(t, err)
+	return cosmos.ValAddress(operator)
+}
+
+// NewContext returns the context of a call of the caller to a precompiled contract,
+// over a StateDB keeping the evm balances in the bank module.
+func (k *Keepers) NewContext(caller common.Address) *precompile.Context {
+	return &precompile.Context{
+		Caller:   caller,
+		StateDB:  states.New(k.Ctx, &stateKeeper{Keepers: k}, states.NewEmptyTxConfig(common.Hash{})),
+		GasMeter: storetypes.NewInfiniteGasMeter(),
+		EVMDenom: Denom,
+	}
+}
+
+// stateKeeper is the states.Keeper of the evm balances in the Denom, the accounts have no code nor storage.
+type stateKeeper struct {
+	*Keepers
+}
+
+func (k *stateKeeper) GetAccount(ctx cosmos.Context, addr common.Address) *states.StateAccount {
+	acc := k.AccountKeeper.GetAccount(ctx, addr.Bytes())
+	if acc == nil {
+		return nil
+	}
+	account := states.NewEmptyAccount()
+	account.Nonce = acc.GetSequence()
+	account.Balance = k.BankKeeper.GetBalance(ctx, addr.Bytes(), Denom).Amount.BigInt()
+	return account
+}
+
+func (k *stateKeeper) SetAccount(ctx cosmos.Context, addr common.Address, account states.StateAccount) error {
+	balance := k.BankKeeper.GetBalance(ctx, addr.Bytes(), Denom).Amount.BigInt()
+	delta := new(big.Int).Sub(account.Balance, balance)
+	switch delta.Sign() {
+	case 1:
+		coins := cosmos.NewCoins(cosmos.NewCoin(Denom, sdkmath.NewIntFromBigInt(delta)))
+		if err := k.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+			return err
+		}
+		return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr.Bytes(), coins)
+	case -1:
+		coins := cosmos.NewCoins(cosmos.NewCoin(Denom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(delta))))
+		if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr.Bytes(), minttypes.ModuleName, coins); err != nil {
+			return err
+		}
+		return k.BankKeeper.BurnCoins(ctx, minttypes.ModuleName, coins)
+	}
+	return nil
+}
+
+func (k *stateKeeper) DeleteAccount(ctx cosmos.Context, addr common.Address) error {
+	return k.SetAccount(ctx, addr, states.StateAccount{Balance: new(big.Int)})
+}
+
+func (k *stateKeeper) GetState(cosmos.Context, common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (k *stateKeeper) GetCode(cosmos.Context, common.Hash) []byte { return nil }
+func (k *stateKeeper) ForEachStorage(cosmos.Context, common.Address, func(key, value common.Hash) bool) {
+}
+func (k *stateKeeper) SetState(cosmos.Context, common.Address, common.Hash, []byte) {}
+func (k *stateKeeper) SetCode(cosmos.Context, []byte, []byte)                       {}
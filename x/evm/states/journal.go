@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"sort"
 
+	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -132,6 +133,13 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+
+	// Changes made by the native actions
+	nativeChange struct {
+		prevCtx    cosmos.Context
+		prevWrites int
+		cached     map[common.Address]struct{}
+	}
 )
 
 // ----------------------------------------------------------------------------
@@ -290,3 +298,22 @@ func (ch transientStorageChange) Revert(s *StateDB) {
 func (ch transientStorageChange) Dirtied() *common.Address {
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// 								nativeChange
+// ----------------------------------------------------------------------------
+
+func (ch nativeChange) Revert(s *StateDB) {
+	s.ctx = ch.prevCtx
+	s.nativeWrites = s.nativeWrites[:ch.prevWrites]
+	// drop the objects loaded from the discarded branch
+	for addr := range s.stateObjects {
+		if _, ok := ch.cached[addr]; !ok {
+			delete(s.stateObjects, addr)
+		}
+	}
+}
+
+func (ch nativeChange) Dirtied() *common.Address {
+	return nil
+}
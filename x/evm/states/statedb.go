@@ -3,6 +3,7 @@ package states
 // Derived from https://github.com/ethereum/go-ethereum/blob/v1.12.0/core/state/statedb.go
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sort"
@@ -54,6 +55,10 @@ type StateDB struct {
 	journal        *journal
 	validRevisions []revision
 	nextRevisionID int
+
+	// Writes of the cached contexts branched by the native actions, in the order
+	// they were branched. They are flushed in reverse order on commit.
+	nativeWrites []func()
 }
 
 // New creates a new states from a given trie.
@@ -347,6 +352,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -371,6 +384,24 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the account with the given one,
+// the slots not present in storage are cleared.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	s.keeper.ForEachStorage(s.ctx, addr, func(key, _ common.Hash) bool {
+		if _, ok := storage[key]; !ok {
+			stateObject.SetState(key, common.Hash{})
+		}
+		return true
+	})
+	for key, value := range storage {
+		stateObject.SetState(key, value)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -477,31 +508,108 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 	s.validRevisions = s.validRevisions[:idx]
 }
 
+// AppendJournalEntry adds an external entry to the states journal, so that it is
+// reverted together with the evm states changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// ExecuteNativeAction runs a native action, e.g. a cosmos module call of a stateful
+// precompiled contract, on a branch of the current context. The dirty states are
+// flushed into the branch before running the action, so the action sees the same
+// states as the evm, and the balances changed by the action are loaded back into
+// the live objects afterwards. The branch is journaled, reverting the states to a
+// snapshot taken before the action discards the branch and the changes of the action.
+func (s *StateDB) ExecuteNativeAction(action func(ctx cosmos.Context) error) error {
+	ctx, write := s.ctx.CacheContext()
+	if err := s.flush(ctx); err != nil {
+		return err
+	}
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	cached := make(map[common.Address]struct{}, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		cached[addr] = struct{}{}
+	}
+	s.journal.append(nativeChange{
+		prevCtx:    s.ctx,
+		prevWrites: len(s.nativeWrites),
+		cached:     cached,
+	})
+	s.ctx = ctx
+	s.nativeWrites = append(s.nativeWrites, write)
+
+	// reload the balances which might be changed by the action
+	for _, addr := range sortedAddresses(cached) {
+		obj := s.stateObjects[addr]
+		if obj.suicided {
+			continue
+		}
+		account := s.keeper.GetAccount(ctx, addr)
+		if account == nil || account.Balance == nil {
+			continue
+		}
+		if account.Balance.Cmp(obj.Balance()) != 0 {
+			obj.SetBalance(account.Balance)
+		}
+	}
+	return nil
+}
+
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
+	if err := s.flush(s.ctx); err != nil {
+		return err
+	}
+	// write the branches of the native actions into their parents, from the innermost one
+	for i := len(s.nativeWrites) - 1; i >= 0; i-- {
+		s.nativeWrites[i]()
+	}
+	s.nativeWrites = nil
+	return nil
+}
+
+// flush writes the dirty states to the given context.
+func (s *StateDB) flush(ctx cosmos.Context) error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
-			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
+			if err := s.keeper.DeleteAccount(ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
 			if obj.code != nil && obj.dirtyCode {
-				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
-			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
+			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
-				// Skip noop changes, persist actual changes
-				if value == obj.originStorage[key] {
+				// Skip noop changes, persist actual changes. The dirty storage might have
+				// been flushed into a branch by a native action already, in which case
+				// a change back to the original value is not a noop.
+				if len(s.nativeWrites) == 0 && value == obj.originStorage[key] {
 					continue
 				}
-				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
 	return nil
 }
+
+// sortedAddresses sorts the addresses for deterministic iteration
+func sortedAddresses(addrs map[common.Address]struct{}) []common.Address {
+	keys := make([]common.Address, 0, len(addrs))
+	for k := range addrs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+	})
+	return keys
+}
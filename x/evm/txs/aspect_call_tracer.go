@@ -0,0 +1,270 @@
+package txs
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	aa "github.com/artela-network/aspect-core/chaincoreext/account_abstraction"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// TracerAspectCall is the name of the call tracer which also records the aspect executions.
+const TracerAspectCall = "aspectCallTracer"
+
+// aspectFrameType is the type of the call frames created by aspect executions.
+const aspectFrameType = "ASPECT"
+
+var (
+	_ tracers.Tracer        = (*aspectCallTracer)(nil)
+	_ asptypes.AspectLogger = (*aspectCallTracer)(nil)
+)
+
+func init() {
+	tracers.DefaultDirectory.Register(TracerAspectCall, newAspectCallTracer, false)
+}
+
+// aspectCallFrame is a call frame of the EVM or an aspect join point execution.
+type aspectCallFrame struct {
+	Type         string            `json:"type"`
+	From         common.Address    `json:"from"`
+	To           *common.Address   `json:"to,omitempty"`
+	AspectId     *common.Address   `json:"aspectId,omitempty"`
+	JoinPoint    string            `json:"joinPoint,omitempty"`
+	Gas          hexutil.Uint64    `json:"gas"`
+	GasUsed      hexutil.Uint64    `json:"gasUsed"`
+	Input        hexutil.Bytes     `json:"input"`
+	ExecContext  json.RawMessage   `json:"execContext,omitempty"`
+	Output       hexutil.Bytes     `json:"output,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	RevertReason string            `json:"revertReason,omitempty"`
+	JITInherent  bool              `json:"jitInherent,omitempty"`
+	Calls        []aspectCallFrame `json:"calls,omitempty"`
+	Value        *hexutil.Big      `json:"value,omitempty"`
+}
+
+func (f *aspectCallFrame) isAspect() bool {
+	return f.Type == aspectFrameType
+}
+
+func (f *aspectCallFrame) processOutput(output []byte, err error) {
+	output = common.CopyBytes(output)
+	if err == nil {
+		f.Output = output
+		return
+	}
+	f.Error = err.Error()
+	if f.Type == vm.CREATE.String() || f.Type == vm.CREATE2.String() {
+		f.To = nil
+	}
+	if len(output) == 0 {
+		return
+	}
+	f.Output = output
+	if unpacked, err := abi.UnpackRevert(output); err == nil {
+		f.RevertReason = unpacked
+	}
+}
+
+type aspectCallTracerConfig struct {
+	OnlyTopCall bool `json:"onlyTopCall"` // If true, the EVM sub calls are not collected, aspect executions still are
+}
+
+// aspectCallTracer is a call tracer which nests the aspect join point executions
+// into the call tree, together with the calls made by the aspects, e.g. static calls
+// and JIT inherent submissions.
+type aspectCallTracer struct {
+	NoOpTracer
+
+	config    aspectCallTracerConfig
+	callstack []aspectCallFrame
+	gasLimit  uint64
+	started   bool
+	// nestedStarts counts the calls entered from depth 0 by the aspects after the transaction call started
+	nestedStarts int
+
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+func newAspectCallTracer(_ *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config aspectCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	// the first frame is the transaction call, it is populated on start and end,
+	// the aspects executed before the transaction call starts are nested into it as well.
+	return &aspectCallTracer{callstack: make([]aspectCallFrame, 1), config: config}, nil
+}
+
+// CaptureAspectEnter implements asptypes.AspectLogger interface
+func (t *aspectCallTracer) CaptureAspectEnter(joinpoint asptypes.JoinPointRunType, from, to, aspectId common.Address, input []byte, gas uint64, value *big.Int, execCtx proto.Message) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	toCopy, aspectCopy := to, aspectId
+	frame := aspectCallFrame{
+		Type:      aspectFrameType,
+		From:      from,
+		To:        &toCopy,
+		AspectId:  &aspectCopy,
+		JoinPoint: joinpoint.String(),
+		Gas:       hexutil.Uint64(gas),
+		Input:     common.CopyBytes(input),
+		Value:     (*hexutil.Big)(value),
+	}
+	if execCtx != nil {
+		rawExecCtx, err := protojson.Marshal(execCtx)
+		if err != nil {
+			t.Stop(err)
+			return
+		}
+		frame.ExecContext = rawExecCtx
+	}
+
+	t.callstack = append(t.callstack, frame)
+}
+
+// CaptureAspectExit implements asptypes.AspectLogger interface
+func (t *aspectCallTracer) CaptureAspectExit(_ asptypes.JoinPointRunType, result *asptypes.AspectExecutionResult) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	size := len(t.callstack)
+	if size <= 1 || !t.callstack[size-1].isAspect() {
+		return
+	}
+
+	frame := t.callstack[size-1]
+	if result != nil {
+		if uint64(frame.Gas) > result.Gas {
+			frame.GasUsed = frame.Gas - hexutil.Uint64(result.Gas)
+		}
+		frame.processOutput(result.Ret, result.Err)
+	}
+	t.pop(frame)
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if t.started {
+		// an aspect is calling from depth 0, e.g. a JIT inherent submitted in the join points of the top call
+		t.nestedStarts++
+		typ := vm.CALL
+		if create {
+			typ = vm.CREATE
+		}
+		t.CaptureEnter(typ, from, to, input, gas, value)
+		return
+	}
+	t.started = true
+
+	// keep the frames of the aspects executed before the transaction call
+	toCopy := to
+	root := &t.callstack[0]
+	root.Type = vm.CALL.String()
+	if create {
+		root.Type = vm.CREATE.String()
+	}
+	root.From = from
+	root.To = &toCopy
+	root.Input = common.CopyBytes(input)
+	root.Gas = hexutil.Uint64(t.gasLimit)
+	root.Value = (*hexutil.Big)(value)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if t.nestedStarts > 0 {
+		t.nestedStarts--
+		t.CaptureExit(output, gasUsed, err)
+		return
+	}
+	t.callstack[0].processOutput(output, err)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall || t.interrupt.Load() {
+		return
+	}
+
+	toCopy := to
+	parent := &t.callstack[len(t.callstack)-1]
+	t.callstack = append(t.callstack, aspectCallFrame{
+		Type:        typ.String(),
+		From:        from,
+		To:          &toCopy,
+		Input:       common.CopyBytes(input),
+		Gas:         hexutil.Uint64(gas),
+		Value:       (*hexutil.Big)(value),
+		JITInherent: parent.isAspect() && to == aa.EntryPointContract,
+	})
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.config.OnlyTopCall || t.interrupt.Load() {
+		return
+	}
+
+	size := len(t.callstack)
+	if size <= 1 || t.callstack[size-1].isAspect() {
+		return
+	}
+
+	frame := t.callstack[size-1]
+	frame.GasUsed = hexutil.Uint64(gasUsed)
+	frame.processOutput(output, err)
+	t.pop(frame)
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+// CaptureTxEnd implements vm.EVMLogger interface
+func (t *aspectCallTracer) CaptureTxEnd(restGas uint64) {
+	t.callstack[0].GasUsed = hexutil.Uint64(t.gasLimit - restGas)
+}
+
+// GetResult returns the json-encoded nested list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *aspectCallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *aspectCallTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// pop removes the top frame from the call stack and nests it into its parent.
+func (t *aspectCallTracer) pop(frame aspectCallFrame) {
+	size := len(t.callstack) - 1
+	t.callstack = t.callstack[:size]
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, frame)
+}
@@ -0,0 +1,188 @@
+package txs
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	aa "github.com/artela-network/aspect-core/chaincoreext/account_abstraction"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+var (
+	tracerSender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	tracerContract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	tracerCallee   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	tracerAspect   = common.HexToAddress("0x4000000000000000000000000000000000000004")
+)
+
+func newTestAspectCallTracer(t *testing.T, cfg string) *aspectCallTracer {
+	var rawCfg json.RawMessage
+	if cfg != "" {
+		rawCfg = json.RawMessage(cfg)
+	}
+	tracer, err := tracers.DefaultDirectory.New(TracerAspectCall, nil, rawCfg)
+	require.NoError(t, err)
+	return tracer.(*aspectCallTracer)
+}
+
+func aspectCallResult(t *testing.T, tracer *aspectCallTracer) aspectCallFrame {
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var frame aspectCallFrame
+	require.NoError(t, json.Unmarshal(res, &frame))
+	return frame
+}
+
+func TestAspectCallTracerNestsAspects(t *testing.T) {
+	tracer := newTestAspectCallTracer(t, "")
+
+	tracer.CaptureTxStart(100000)
+	// the aspects of the tx level join points run before the transaction call starts
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreTxExecute, tracerSender, tracerContract, tracerAspect, []byte{1}, 50000, big.NewInt(0), nil)
+	tracer.CaptureAspectExit(asptypes.JoinPointRunType_PreTxExecute, &asptypes.AspectExecutionResult{Gas: 45000})
+
+	tracer.CaptureStart(nil, tracerSender, tracerContract, false, []byte{2}, 80000, big.NewInt(1))
+	tracer.CaptureEnter(vm.CALL, tracerContract, tracerCallee, []byte{3}, 30000, big.NewInt(0))
+	// the aspects of the call join points run inside the call
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, tracerContract, tracerCallee, tracerAspect, []byte{4}, 20000, big.NewInt(0), nil)
+	tracer.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 19000, Ret: []byte{5}})
+	tracer.CaptureExit([]byte{6}, 10000, nil)
+	tracer.CaptureEnd([]byte{7}, 60000, nil)
+	tracer.CaptureTxEnd(30000)
+
+	root := aspectCallResult(t, tracer)
+	require.Equal(t, vm.CALL.String(), root.Type)
+	require.Equal(t, tracerSender, root.From)
+	require.Equal(t, tracerContract, *root.To)
+	require.Equal(t, uint64(100000), uint64(root.Gas))
+	require.Equal(t, uint64(70000), uint64(root.GasUsed))
+	require.Equal(t, []byte{7}, []byte(root.Output))
+	require.Len(t, root.Calls, 2)
+
+	preTx := root.Calls[0]
+	require.Equal(t, aspectFrameType, preTx.Type)
+	require.Equal(t, asptypes.JoinPointRunType_PreTxExecute.String(), preTx.JoinPoint)
+	require.Equal(t, tracerAspect, *preTx.AspectId)
+	require.Equal(t, uint64(5000), uint64(preTx.GasUsed))
+
+	call := root.Calls[1]
+	require.Equal(t, vm.CALL.String(), call.Type)
+	require.Equal(t, tracerCallee, *call.To)
+	require.Equal(t, uint64(10000), uint64(call.GasUsed))
+	require.Equal(t, []byte{6}, []byte(call.Output))
+	require.Len(t, call.Calls, 1)
+
+	preCall := call.Calls[0]
+	require.Equal(t, aspectFrameType, preCall.Type)
+	require.Equal(t, asptypes.JoinPointRunType_PreContractCall.String(), preCall.JoinPoint)
+	require.Equal(t, uint64(1000), uint64(preCall.GasUsed))
+	require.Equal(t, []byte{5}, []byte(preCall.Output))
+}
+
+func TestAspectCallTracerJITInherent(t *testing.T) {
+	tracer := newTestAspectCallTracer(t, "")
+
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, tracerSender, tracerContract, false, nil, 80000, big.NewInt(0))
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PostContractCall, tracerSender, tracerContract, tracerAspect, nil, 40000, big.NewInt(0), nil)
+	// the aspect submits a JIT inherent, which is started from depth 0 again
+	tracer.CaptureStart(nil, tracerAspect, aa.EntryPointContract, false, []byte{1}, 30000, big.NewInt(0))
+	tracer.CaptureEnd(nil, 20000, nil)
+	tracer.CaptureAspectExit(asptypes.JoinPointRunType_PostContractCall, &asptypes.AspectExecutionResult{Gas: 10000})
+	tracer.CaptureEnd(nil, 70000, nil)
+	tracer.CaptureTxEnd(0)
+
+	root := aspectCallResult(t, tracer)
+	require.Equal(t, tracerContract, *root.To)
+	require.Len(t, root.Calls, 1)
+
+	aspect := root.Calls[0]
+	require.True(t, aspect.isAspect())
+	require.Equal(t, uint64(30000), uint64(aspect.GasUsed))
+	require.Len(t, aspect.Calls, 1)
+
+	inherent := aspect.Calls[0]
+	require.Equal(t, vm.CALL.String(), inherent.Type)
+	require.Equal(t, aa.EntryPointContract, *inherent.To)
+	require.Equal(t, uint64(20000), uint64(inherent.GasUsed))
+	require.True(t, inherent.JITInherent)
+}
+
+func TestAspectCallTracerOnlyTopCall(t *testing.T) {
+	tracer := newTestAspectCallTracer(t, `{"onlyTopCall": true}`)
+
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, tracerSender, tracerContract, false, nil, 80000, big.NewInt(0))
+	tracer.CaptureEnter(vm.STATICCALL, tracerContract, tracerCallee, nil, 30000, nil)
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, tracerContract, tracerCallee, tracerAspect, nil, 20000, big.NewInt(0), nil)
+	tracer.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 20000})
+	tracer.CaptureExit(nil, 10000, nil)
+	tracer.CaptureEnd(nil, 60000, nil)
+	tracer.CaptureTxEnd(40000)
+
+	// the sub calls are dropped, the aspects are kept
+	root := aspectCallResult(t, tracer)
+	require.Len(t, root.Calls, 1)
+	require.True(t, root.Calls[0].isAspect())
+	require.Empty(t, root.Calls[0].Calls)
+}
+
+func TestAspectCallTracerErrors(t *testing.T) {
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], mustPackRevertReason(t, "not allowed")...)
+
+	tracer := newTestAspectCallTracer(t, "")
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, tracerSender, tracerContract, true, nil, 80000, big.NewInt(0))
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, tracerSender, tracerContract, tracerAspect, nil, 20000, big.NewInt(0), nil)
+	tracer.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 0, Err: errors.New("aspect failed")})
+	tracer.CaptureEnd(revertData, 80000, vm.ErrExecutionReverted)
+	tracer.CaptureTxEnd(0)
+
+	root := aspectCallResult(t, tracer)
+	require.Equal(t, vm.CREATE.String(), root.Type)
+	require.Nil(t, root.To)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), root.Error)
+	require.Equal(t, "not allowed", root.RevertReason)
+	require.Len(t, root.Calls, 1)
+	require.Equal(t, "aspect failed", root.Calls[0].Error)
+}
+
+func TestAspectCallTracerStop(t *testing.T) {
+	tracer := newTestAspectCallTracer(t, "")
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, tracerSender, tracerContract, false, nil, 80000, big.NewInt(0))
+
+	stopErr := errors.New("execution timeout")
+	tracer.Stop(stopErr)
+
+	// nothing is captured once the tracer is stopped
+	tracer.CaptureEnter(vm.CALL, tracerContract, tracerCallee, nil, 30000, big.NewInt(0))
+	tracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, tracerContract, tracerCallee, tracerAspect, nil, 20000, big.NewInt(0), nil)
+	tracer.CaptureEnd(nil, 10000, nil)
+	tracer.CaptureTxEnd(90000)
+
+	res, err := tracer.GetResult()
+	require.ErrorIs(t, err, stopErr)
+
+	var root aspectCallFrame
+	require.NoError(t, json.Unmarshal(res, &root))
+	require.Empty(t, root.Calls)
+}
+
+func mustPackRevertReason(t *testing.T, reason string) []byte {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	bz, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.NoError(t, err)
+	return bz
+}
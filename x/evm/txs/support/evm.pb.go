@@ -41,6 +41,9 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the states machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the stateful precompiled contracts
+	// which are enabled, the ones not listed here revert when they are called.
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -118,6 +121,13 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -446,6 +456,88 @@ func (m *Log) GetRemoved() bool {
 	return false
 }
 
+// AspectExecution records the execution of an aspect at a join point of a txs.
+type AspectExecution struct {
+	// join_point is the name of the join point where the aspect was executed
+	JoinPoint string `protobuf:"bytes,1,opt,name=join_point,json=joinPoint,proto3" json:"joinPoint"`
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,2,opt,name=aspect_id,json=aspectId,proto3" json:"aspectId"`
+	// version of the executed aspect
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// gas_used is the gas consumed by the aspect execution
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
+	// error is the error returned by the aspect execution, empty if succeeded
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AspectExecution) Reset()         { *m = AspectExecution{} }
+func (m *AspectExecution) String() string { return proto.CompactTextString(m) }
+func (*AspectExecution) ProtoMessage()    {}
+func (*AspectExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95fb7abfbae4d4d, []int{5}
+}
+func (m *AspectExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectExecution.Merge(m, src)
+}
+func (m *AspectExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectExecution proto.InternalMessageInfo
+
+func (m *AspectExecution) GetJoinPoint() string {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return ""
+}
+
+func (m *AspectExecution) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *AspectExecution) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AspectExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *AspectExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // TxResult stores results of Tx execution.
 type TxResult struct {
 	// contract_address contains the ethereum address of the created contract (if
@@ -469,7 +561,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c95fb7abfbae4d4d, []int{5}
+	return fileDescriptor_c95fb7abfbae4d4d, []int{6}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +602,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c95fb7abfbae4d4d, []int{6}
+	return fileDescriptor_c95fb7abfbae4d4d, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +662,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c95fb7abfbae4d4d, []int{7}
+	return fileDescriptor_c95fb7abfbae4d4d, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,6 +774,7 @@ func init() {
 	proto.RegisterType((*State)(nil), "artela.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "artela.evm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "artela.evm.v1.Log")
+	proto.RegisterType((*AspectExecution)(nil), "artela.evm.v1.AspectExecution")
 	proto.RegisterType((*TxResult)(nil), "artela.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "artela.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "artela.evm.v1.TraceConfig")
@@ -690,108 +783,116 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/evm.proto", fileDescriptor_c95fb7abfbae4d4d) }
 
 var fileDescriptor_c95fb7abfbae4d4d = []byte{
-	// 1605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x23, 0xb7,
-	0x19, 0xb6, 0xad, 0xb1, 0x3d, 0xa2, 0xbe, 0xc6, 0xb4, 0xd6, 0xab, 0xec, 0x02, 0x1e, 0x63, 0x0e,
-	0x81, 0x0f, 0x59, 0x2b, 0x76, 0x60, 0x74, 0x91, 0xa2, 0x05, 0xac, 0x5d, 0x27, 0xb1, 0xbb, 0x49,
-	0x16, 0x5c, 0x07, 0x05, 0x72, 0x19, 0x50, 0x33, 0xcc, 0x68, 0xa2, 0x99, 0xa1, 0x40, 0x72, 0xb4,
-	0x52, 0xdb, 0x73, 0x91, 0x63, 0xff, 0x40, 0x8b, 0xfe, 0x9c, 0xa0, 0xa7, 0x1c, 0x8b, 0x1e, 0x06,
-	0x85, 0xf7, 0xe6, 0xa3, 0x7e, 0x41, 0xc1, 0x0f, 0x7d, 0xda, 0x08, 0x6a, 0x9d, 0x34, 0xcf, 0xfb,
-	0xf1, 0x3c, 0xe4, 0xcb, 0x97, 0x22, 0x09, 0x9e, 0x62, 0x26, 0x48, 0x82, 0xdb, 0x64, 0x98, 0xb6,
-	0x87, 0xa7, 0xf2, 0xe7, 0x64, 0xc0, 0xa8, 0xa0, 0xb0, 0xa6, 0x1d, 0x27, 0xd2, 0x32, 0x3c, 0x7d,
-	0xd6, 0x8c, 0x68, 0x44, 0x95, 0xa7, 0x2d, 0xbf, 0x74, 0x90, 0xf7, 0xd7, 0x12, 0xd8, 0x79, 0x8b,
-	0x19, 0x4e, 0x39, 0x3c, 0x05, 0x65, 0x32, 0x4c, 0xfd, 0x90, 0x64, 0x34, 0x6d, 0x6d, 0x1e, 0x6d,
-	0x1e, 0x97, 0x3b, 0xcd, 0x49, 0xe1, 0x3a, 0x63, 0x9c, 0x26, 0x9f, 0x7b, 0x33, 0x97, 0x87, 0x6c,
-	0x32, 0x4c, 0x5f, 0xcb, 0x4f, 0xf8, 0x3b, 0x50, 0x23, 0x19, 0xee, 0x26, 0xc4, 0x0f, 0x18, 0xc1,
-	0x82, 0xb4, 0xb6, 0x8e, 0x36, 0x8f, 0xed, 0x4e, 0x6b, 0x52, 0xb8, 0x4d, 0x93, 0xb6, 0xe8, 0xf6,
-	0x50, 0x55, 0xe3, 0x57, 0x0a, 0xc2, 0xdf, 0x80, 0xca, 0xd4, 0x8f, 0x93, 0xa4, 0x55, 0x52, 0xc9,
-	0x07, 0x93, 0xc2, 0x85, 0xcb, 0xc9, 0x38, 0x49, 0x3c, 0x04, 0x4c, 0x2a, 0x4e, 0x12, 0x78, 0x01,
-	0x00, 0x19, 0x09, 0x86, 0x7d, 0x12, 0x0f, 0x78, 0xcb, 0x3a, 0x2a, 0x1d, 0x97, 0x3a, 0xde, 0x6d,
-	0xe1, 0x96, 0x2f, 0xa5, 0xf5, 0xf2, 0xea, 0x2d, 0x9f, 0x14, 0xee, 0x9e, 0x21, 0x99, 0x05, 0x7a,
-	0xa8, 0xac, 0xc0, 0x65, 0x3c, 0xe0, 0xf0, 0x7b, 0x50, 0x0d, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66,
-	0x3f, 0xc4, 0x51, 0x6b, 0xfb, 0x68, 0xf3, 0xb8, 0x72, 0xf6, 0xec, 0x64, 0xa9, 0x68, 0x27, 0xaf,
-	0x64, 0xc8, 0x2b, 0x15, 0xd1, 0x79, 0xfe, 0x73, 0xe1, 0x6e, 0x4c, 0x0a, 0x77, 0x5f, 0xf3, 0x2e,
-	0x66, 0x7b, 0xa8, 0x12, 0xcc, 0x23, 0xe1, 0x19, 0x78, 0x82, 0x93, 0x84, 0xbe, 0xf7, 0xf3, 0x4c,
-	0x56, 0x99, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf1, 0xd6, 0x8e, 0x9c, 0x21, 0xda, 0x57, 0xce, 0xef,
-	0xe6, 0xbe, 0x9b, 0x11, 0xf7, 0xfe, 0xb1, 0x07, 0x2a, 0x0b, 0x6a, 0x30, 0x05, 0x8d, 0x1e, 0x4d,
-	0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0x41, 0xdf, 0xac, 0xc9, 0xeb, 0xff, 0x14, 0xee, 0xc7,
-	0x51, 0x2c, 0x7a, 0x79, 0xf7, 0x24, 0xa0, 0x69, 0x3b, 0xa0, 0x3c, 0xa5, 0xdc, 0xfc, 0xbc, 0xe0,
-	0x61, 0xbf, 0x2d, 0xc6, 0x03, 0xc2, 0x4f, 0xae, 0x32, 0x31, 0x29, 0xdc, 0x03, 0x3d, 0xd8, 0x15,
-	0x2a, 0x0f, 0xd5, 0x67, 0x96, 0x8e, 0x34, 0xc0, 0x31, 0xa8, 0x87, 0x98, 0xfa, 0x3f, 0x50, 0xd6,
-	0x37, 0x6a, 0x5b, 0x4a, 0xed, 0xdd, 0xff, 0xaf, 0x76, 0x5b, 0xb8, 0xd5, 0xd7, 0x17, 0xdf, 0x7e,
-	0x41, 0x59, 0x5f, 0x71, 0x4e, 0x0a, 0xf7, 0x89, 0x56, 0x5f, 0x66, 0xf6, 0x50, 0x35, 0xc4, 0x74,
-	0x16, 0x06, 0xff, 0x08, 0x9c, 0x59, 0x00, 0xcf, 0x07, 0x03, 0xca, 0x84, 0x69, 0x85, 0x17, 0xb7,
-	0x85, 0x5b, 0x37, 0x94, 0xef, 0xb4, 0x67, 0x52, 0xb8, 0x4f, 0x57, 0x48, 0x4d, 0x8e, 0x87, 0xea,
-	0x86, 0xd6, 0x84, 0x42, 0x0e, 0xaa, 0x24, 0x1e, 0x9c, 0x9e, 0x7f, 0x6a, 0x66, 0x64, 0xa9, 0x19,
-	0xbd, 0x7d, 0xd4, 0x8c, 0x2a, 0x97, 0x57, 0x6f, 0x4f, 0xcf, 0x3f, 0x9d, 0x4e, 0xc8, 0xac, 0xfd,
-	0x22, 0xad, 0x87, 0x2a, 0x1a, 0xea, 0xd9, 0x5c, 0x01, 0x03, 0xfd, 0x1e, 0xe6, 0x3d, 0xd5, 0x56,
-	0xe5, 0xce, 0xf1, 0x6d, 0xe1, 0x02, 0xcd, 0xf4, 0x15, 0xe6, 0xbd, 0xf9, 0xba, 0x74, 0xc7, 0x7f,
-	0xc2, 0x99, 0x88, 0xf3, 0x74, 0xca, 0x05, 0x74, 0xb2, 0x8c, 0x9a, 0x8d, 0xff, 0xdc, 0x8c, 0x7f,
-	0x67, 0xed, 0xf1, 0x9f, 0x3f, 0x34, 0xfe, 0xf3, 0xe5, 0xf1, 0xeb, 0x98, 0x99, 0xe8, 0x4b, 0x23,
-	0xba, 0xbb, 0xb6, 0xe8, 0xcb, 0x87, 0x44, 0x5f, 0x2e, 0x8b, 0xea, 0x18, 0xd9, 0xec, 0x2b, 0x95,
-	0x68, 0xd9, 0xeb, 0x37, 0xfb, 0xbd, 0xa2, 0xd6, 0x67, 0x16, 0x2d, 0xf7, 0x17, 0xd0, 0x0c, 0x68,
-	0xc6, 0x85, 0xb4, 0x65, 0x74, 0x90, 0x10, 0xa3, 0x59, 0x56, 0x9a, 0x57, 0x8f, 0xd2, 0x7c, 0x6e,
-	0xfe, 0x0d, 0x1e, 0xe0, 0xf3, 0xd0, 0xfe, 0xb2, 0x59, 0xab, 0x0f, 0x80, 0x33, 0x20, 0x82, 0x30,
-	0xde, 0xcd, 0x59, 0x64, 0x94, 0x81, 0x52, 0xbe, 0x7c, 0x94, 0xb2, 0xd9, 0x07, 0xab, 0x5c, 0x1e,
-	0x6a, 0xcc, 0x4d, 0x5a, 0xf1, 0x47, 0x50, 0x8f, 0xe5, 0x30, 0xba, 0x79, 0x62, 0xf4, 0x2a, 0x4a,
-	0xef, 0xd5, 0xa3, 0xf4, 0xcc, 0x66, 0x5e, 0x66, 0xf2, 0x50, 0x6d, 0x6a, 0xd0, 0x5a, 0x39, 0x80,
-	0x69, 0x1e, 0x33, 0x3f, 0x4a, 0x70, 0x10, 0x13, 0x66, 0xf4, 0xaa, 0x4a, 0xef, 0xcb, 0x47, 0xe9,
-	0x7d, 0xa4, 0xf5, 0xee, 0xb3, 0x79, 0xc8, 0x91, 0xc6, 0x2f, 0xb5, 0x4d, 0xcb, 0x86, 0xa0, 0xda,
-	0x25, 0x2c, 0x89, 0x33, 0x23, 0x58, 0x53, 0x82, 0x17, 0x8f, 0x12, 0x34, 0x7d, 0xba, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x33, 0x95, 0x84, 0x66, 0x21, 0x9d, 0xaa, 0xec, 0xad, 0xaf, 0xb2, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x5a, 0x65, 0x04, 0xf6, 0x31, 0x63, 0xf4, 0xfd, 0x4a, 0x0d, 0xa1, 0x12, 0xfb,
-	0xea, 0x51, 0x62, 0xcf, 0xb4, 0xd8, 0x03, 0x74, 0x1e, 0xda, 0x53, 0xd6, 0xa5, 0x2a, 0xe6, 0x00,
-	0x46, 0x0c, 0x8f, 0x57, 0x84, 0x9b, 0xeb, 0x2f, 0xde, 0x7d, 0x36, 0x0f, 0x39, 0xd2, 0xb8, 0x24,
-	0xfb, 0x67, 0xd0, 0x4c, 0x09, 0x8b, 0x88, 0x9f, 0x11, 0xc1, 0x07, 0x49, 0x2c, 0x8c, 0xf0, 0x93,
-	0xf5, 0xf7, 0xe3, 0x43, 0x7c, 0x1e, 0x82, 0xca, 0xfc, 0x8d, 0xb1, 0xce, 0x36, 0x07, 0xef, 0xe1,
-	0x2c, 0xea, 0xe1, 0xd8, 0xc8, 0x1e, 0xac, 0xbf, 0x39, 0x96, 0x99, 0x3c, 0x54, 0x9b, 0x1a, 0x66,
-	0xfd, 0x13, 0xe0, 0x2c, 0xc8, 0xa7, 0xfd, 0xf3, 0x74, 0xfd, 0xfe, 0x59, 0xe4, 0x91, 0xd7, 0x0f,
-	0x05, 0x95, 0xca, 0xb5, 0x65, 0xd7, 0x9d, 0xc6, 0xb5, 0x65, 0x37, 0x1c, 0xe7, 0xda, 0xb2, 0x1d,
-	0x67, 0xef, 0xda, 0xb2, 0xf7, 0x9d, 0x26, 0xaa, 0x8d, 0x69, 0x42, 0xfd, 0xe1, 0x67, 0x3a, 0x09,
-	0x55, 0xc8, 0x7b, 0xcc, 0xcd, 0x7f, 0x24, 0xaa, 0x07, 0x58, 0xe0, 0x64, 0xcc, 0x4d, 0xa9, 0x90,
-	0xa3, 0x0b, 0xb8, 0x70, 0x6a, 0xb7, 0xc1, 0xf6, 0x3b, 0x21, 0x6f, 0x6d, 0x0e, 0x28, 0xf5, 0xc9,
-	0x58, 0xdf, 0x46, 0x90, 0xfc, 0x84, 0x4d, 0xb0, 0x3d, 0xc4, 0x49, 0xae, 0xaf, 0x7f, 0x65, 0xa4,
-	0x81, 0xf7, 0x35, 0x68, 0xdc, 0x30, 0x9c, 0x71, 0x1c, 0x88, 0x98, 0x66, 0x6f, 0x68, 0xc4, 0x21,
-	0x04, 0x96, 0x3a, 0x15, 0x75, 0xae, 0xfa, 0x86, 0x1f, 0x03, 0x2b, 0xa1, 0x11, 0x6f, 0x6d, 0x1d,
-	0x95, 0x8e, 0x2b, 0x67, 0x70, 0xe5, 0x02, 0xf6, 0x86, 0x46, 0x48, 0xf9, 0xbd, 0x7f, 0x6d, 0x81,
-	0xd2, 0x1b, 0x1a, 0xc1, 0x16, 0xd8, 0xc5, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x66, 0x0a, 0xe1, 0x01,
-	0xd8, 0x11, 0x74, 0x10, 0x07, 0x9a, 0xab, 0x8c, 0x0c, 0x92, 0xaa, 0x21, 0x16, 0x58, 0x5d, 0x2a,
-	0xaa, 0x48, 0x7d, 0xc3, 0x33, 0x50, 0x55, 0xd3, 0xf2, 0xb3, 0x3c, 0xed, 0x12, 0xa6, 0xee, 0x06,
-	0x56, 0xa7, 0x71, 0x57, 0xb8, 0x15, 0x65, 0xff, 0x46, 0x99, 0xd1, 0x22, 0x80, 0x9f, 0x80, 0x5d,
-	0x31, 0x5a, 0x3c, 0xd6, 0xf7, 0xef, 0x0a, 0xb7, 0x21, 0xe6, 0x73, 0x94, 0xa7, 0x36, 0xda, 0x11,
-	0x23, 0x75, 0x7a, 0xb7, 0x81, 0x2d, 0x46, 0x7e, 0x9c, 0x85, 0x64, 0xa4, 0x4e, 0x6e, 0xab, 0xd3,
-	0xbc, 0x2b, 0x5c, 0x67, 0x21, 0xfc, 0x4a, 0xfa, 0xd0, 0xae, 0x18, 0xa9, 0x0f, 0xf8, 0x09, 0x00,
-	0x7a, 0x48, 0x4a, 0x41, 0x9f, 0xbb, 0xb5, 0xbb, 0xc2, 0x2d, 0x2b, 0xab, 0xe2, 0x9e, 0x7f, 0x42,
-	0x0f, 0x6c, 0x6b, 0x6e, 0x5b, 0x71, 0x57, 0xef, 0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b,
-	0x96, 0x8a, 0x91, 0x94, 0x0e, 0x49, 0xa8, 0x8e, 0x36, 0x1b, 0x4d, 0xa1, 0xf7, 0xd3, 0x16, 0xb0,
-	0x6f, 0x46, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x0b, 0xe0, 0x04, 0x34, 0x13, 0x0c, 0x07, 0xc2, 0x5f,
-	0x2a, 0x6d, 0xe7, 0xf9, 0xfc, 0x98, 0x59, 0x8d, 0xf0, 0x50, 0x63, 0x6a, 0xba, 0x30, 0xf5, 0x6f,
-	0x82, 0xed, 0x6e, 0x42, 0x69, 0xaa, 0xda, 0xa0, 0x8a, 0x34, 0x80, 0xdf, 0xaa, 0xaa, 0xa9, 0x25,
-	0x2e, 0xa9, 0x3b, 0xf6, 0xe1, 0xca, 0x12, 0xaf, 0x34, 0x49, 0xe7, 0xc0, 0xdc, 0xb3, 0xeb, 0x5a,
-	0xd8, 0x24, 0x7b, 0xb2, 0xb0, 0xaa, 0x89, 0x1c, 0x50, 0x62, 0x44, 0xa8, 0x15, 0xab, 0x22, 0xf9,
-	0x09, 0x9f, 0x01, 0x9b, 0x91, 0x21, 0x61, 0x82, 0x84, 0x6a, 0x65, 0x6c, 0x34, 0xc3, 0xf0, 0x23,
-	0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0x5e, 0x06, 0xb4, 0x1b, 0x61, 0xfe, 0x1d, 0x27, 0xe1,
-	0xe7, 0xd6, 0x4f, 0xff, 0x74, 0x37, 0x3c, 0x0c, 0x2a, 0x17, 0x41, 0x40, 0x38, 0xbf, 0xc9, 0x07,
-	0x09, 0xf9, 0x95, 0xf6, 0x3a, 0x03, 0x55, 0x2e, 0x28, 0xc3, 0x11, 0xf1, 0xfb, 0x64, 0x6c, 0x9a,
-	0x4c, 0xb7, 0x8c, 0xb1, 0xff, 0x81, 0x8c, 0x39, 0x5a, 0x04, 0x46, 0xe2, 0xef, 0x16, 0xa8, 0xdc,
-	0x30, 0x1c, 0x10, 0x73, 0xb7, 0x97, 0x8d, 0x2a, 0x21, 0x33, 0x12, 0x06, 0x49, 0x6d, 0x11, 0xa7,
-	0x84, 0xe6, 0xc2, 0xec, 0xa4, 0x29, 0x94, 0x19, 0x8c, 0x90, 0x11, 0x09, 0x54, 0x0d, 0x2d, 0x64,
-	0x10, 0x3c, 0x07, 0xb5, 0x30, 0xe6, 0xea, 0x95, 0xc4, 0x05, 0x0e, 0xfa, 0x7a, 0xfa, 0x1d, 0xe7,
-	0xae, 0x70, 0xab, 0xc6, 0xf1, 0x4e, 0xda, 0xd1, 0x12, 0x82, 0xbf, 0x05, 0x8d, 0x79, 0x9a, 0x1a,
-	0xad, 0x7e, 0x9a, 0x74, 0xe0, 0x5d, 0xe1, 0xd6, 0x67, 0xa1, 0xca, 0x83, 0x56, 0xb0, 0x5c, 0xe6,
-	0x90, 0x74, 0xf3, 0x48, 0x75, 0x9e, 0x8d, 0x34, 0x90, 0xd6, 0x24, 0x4e, 0x63, 0xa1, 0x3a, 0x6d,
-	0x1b, 0x69, 0x00, 0x5f, 0x82, 0x32, 0x1d, 0x12, 0xc6, 0xe2, 0x90, 0x70, 0x75, 0xc9, 0xf9, 0xd5,
-	0x27, 0x16, 0x9a, 0x07, 0xcb, 0x99, 0x99, 0xe7, 0x5f, 0x4a, 0x52, 0xca, 0xc6, 0xea, 0xca, 0x62,
-	0x66, 0xa6, 0x1d, 0x5f, 0x2b, 0x3b, 0x5a, 0x42, 0xb0, 0x03, 0xa0, 0x49, 0x63, 0x44, 0xe4, 0x2c,
-	0xf3, 0xd5, 0xce, 0xaf, 0xaa, 0x5c, 0xb5, 0xff, 0xb4, 0x17, 0x29, 0xe7, 0x6b, 0x2c, 0x30, 0xba,
-	0x67, 0x81, 0xbf, 0x07, 0x50, 0x2f, 0x88, 0xff, 0x23, 0xa7, 0xb3, 0x07, 0xa2, 0xbe, 0x51, 0x28,
-	0x7d, 0xed, 0x35, 0x63, 0x76, 0x34, 0xba, 0xe6, 0xd4, 0xcc, 0xe2, 0xda, 0xb2, 0x2d, 0x67, 0xfb,
-	0xda, 0xb2, 0x77, 0x1d, 0x7b, 0x56, 0x3c, 0x33, 0x0b, 0xb4, 0x3f, 0xc5, 0x0b, 0xc3, 0xeb, 0x5c,
-	0xfd, 0x7c, 0x7b, 0xb8, 0xf9, 0xcb, 0xed, 0xe1, 0xe6, 0x7f, 0x6f, 0x0f, 0x37, 0xff, 0xf6, 0xe1,
-	0x70, 0xe3, 0x97, 0x0f, 0x87, 0x1b, 0xff, 0xfe, 0x70, 0xb8, 0xf1, 0x7d, 0x7b, 0xe1, 0x58, 0xd0,
-	0x65, 0x7b, 0x91, 0x11, 0xf1, 0x9e, 0xb2, 0xbe, 0x81, 0xf2, 0xc9, 0x3f, 0x52, 0x6f, 0x7f, 0x75,
-	0x46, 0x74, 0x77, 0xd4, 0xb3, 0xfe, 0xb3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6e, 0xd7,
-	0xa7, 0x16, 0x10, 0x00, 0x00,
+	// 1733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0x23, 0xb7,
+	0x15, 0xb7, 0x2c, 0xd9, 0x1e, 0x51, 0xb2, 0x34, 0xa6, 0xb5, 0x5e, 0x65, 0x17, 0xf5, 0x18, 0x73,
+	0x58, 0xb8, 0x40, 0xd6, 0x8e, 0x1d, 0x18, 0x5d, 0xa4, 0x68, 0x01, 0x6b, 0xd7, 0x49, 0xec, 0x6e,
+	0x12, 0x83, 0xeb, 0xa0, 0x40, 0x2e, 0x03, 0x6a, 0x86, 0x19, 0xcf, 0x7a, 0x66, 0x38, 0x20, 0x39,
+	0x5a, 0xa9, 0xed, 0x07, 0xc8, 0xb1, 0x5f, 0xa0, 0x45, 0x3f, 0x4b, 0x4f, 0x41, 0x4f, 0x39, 0xf4,
+	0x50, 0xf4, 0x30, 0x28, 0xbc, 0x37, 0x1f, 0xf5, 0x09, 0x0a, 0xfe, 0x91, 0x34, 0x92, 0x17, 0x6d,
+	0xac, 0x93, 0xf8, 0xfb, 0xbd, 0xc7, 0xf7, 0xe3, 0x23, 0x1f, 0x87, 0xa4, 0xc0, 0x63, 0xcc, 0x04,
+	0x89, 0xf1, 0x21, 0x19, 0x24, 0x87, 0x83, 0x23, 0xf9, 0x73, 0x90, 0x31, 0x2a, 0x28, 0xdc, 0xd4,
+	0x86, 0x03, 0xc9, 0x0c, 0x8e, 0x9e, 0x74, 0x42, 0x1a, 0x52, 0x65, 0x39, 0x94, 0x2d, 0xed, 0xe4,
+	0xfe, 0xb3, 0x0a, 0xd6, 0x2f, 0x31, 0xc3, 0x09, 0x87, 0x47, 0xa0, 0x4e, 0x06, 0x89, 0x17, 0x90,
+	0x94, 0x26, 0xdd, 0xca, 0x5e, 0x65, 0xbf, 0xde, 0xeb, 0x8c, 0x0b, 0xc7, 0x1e, 0xe1, 0x24, 0xfe,
+	0xcc, 0x9d, 0x9a, 0x5c, 0x64, 0x91, 0x41, 0xf2, 0x4a, 0x36, 0xe1, 0x6f, 0xc0, 0x26, 0x49, 0x71,
+	0x3f, 0x26, 0x9e, 0xcf, 0x08, 0x16, 0xa4, 0xbb, 0xba, 0x57, 0xd9, 0xb7, 0x7a, 0xdd, 0x71, 0xe1,
+	0x74, 0x4c, 0xb7, 0xb2, 0xd9, 0x45, 0x4d, 0x8d, 0x5f, 0x2a, 0x08, 0x7f, 0x05, 0x1a, 0x13, 0x3b,
+	0x8e, 0xe3, 0x6e, 0x55, 0x75, 0xde, 0x19, 0x17, 0x0e, 0x9c, 0xef, 0x8c, 0xe3, 0xd8, 0x45, 0xc0,
+	0x74, 0xc5, 0x71, 0x0c, 0x4f, 0x01, 0x20, 0x43, 0xc1, 0xb0, 0x47, 0xa2, 0x8c, 0x77, 0x6b, 0x7b,
+	0xd5, 0xfd, 0x6a, 0xcf, 0xbd, 0x2d, 0x9c, 0xfa, 0x99, 0x64, 0xcf, 0xce, 0x2f, 0xf9, 0xb8, 0x70,
+	0xb6, 0x4c, 0x90, 0xa9, 0xa3, 0x8b, 0xea, 0x0a, 0x9c, 0x45, 0x19, 0x87, 0xdf, 0x81, 0xa6, 0x7f,
+	0x8d, 0xa3, 0xd4, 0xf3, 0x69, 0xfa, 0x7d, 0x14, 0x76, 0xd7, 0xf6, 0x2a, 0xfb, 0x8d, 0xe3, 0x27,
+	0x07, 0x73, 0x93, 0x76, 0xf0, 0x52, 0xba, 0xbc, 0x54, 0x1e, 0xbd, 0xa7, 0x3f, 0x16, 0xce, 0xca,
+	0xb8, 0x70, 0xb6, 0x75, 0xdc, 0x72, 0x6f, 0x17, 0x35, 0xfc, 0x99, 0x27, 0x3c, 0x06, 0x8f, 0x70,
+	0x1c, 0xd3, 0x77, 0x5e, 0x9e, 0xca, 0x59, 0x26, 0xbe, 0x20, 0x81, 0x27, 0x86, 0xbc, 0xbb, 0x2e,
+	0x33, 0x44, 0xdb, 0xca, 0xf8, 0xed, 0xcc, 0x76, 0x35, 0xe4, 0xf0, 0x35, 0x80, 0xd8, 0x17, 0xd1,
+	0x80, 0x78, 0x19, 0x23, 0x3e, 0x4d, 0xb2, 0x28, 0x26, 0xbc, 0xbb, 0xb1, 0x57, 0xdd, 0xaf, 0xf7,
+	0x7e, 0x31, 0x2e, 0x9c, 0x8f, 0xb4, 0xea, 0x7d, 0x1f, 0x17, 0x6d, 0x69, 0xf2, 0xb2, 0xc4, 0xfd,
+	0x75, 0x0b, 0x34, 0x4a, 0x63, 0x87, 0x09, 0x68, 0x5f, 0xd3, 0x84, 0x70, 0x41, 0x70, 0xe0, 0xf5,
+	0x63, 0xea, 0xdf, 0x98, 0x15, 0x7e, 0xf5, 0xef, 0xc2, 0x79, 0x16, 0x46, 0xe2, 0x3a, 0xef, 0x1f,
+	0xf8, 0x34, 0x39, 0xf4, 0x29, 0x4f, 0x28, 0x37, 0x3f, 0xcf, 0x79, 0x70, 0x73, 0x28, 0x46, 0x19,
+	0xe1, 0x07, 0xe7, 0xa9, 0x18, 0x17, 0xce, 0x8e, 0x1e, 0xc4, 0x42, 0x28, 0x17, 0xb5, 0xa6, 0x4c,
+	0x4f, 0x12, 0x70, 0x04, 0x5a, 0x01, 0xa6, 0xde, 0xf7, 0x94, 0xdd, 0x18, 0xb5, 0x55, 0xa5, 0xf6,
+	0xe6, 0xe7, 0xab, 0xdd, 0x16, 0x4e, 0xf3, 0xd5, 0xe9, 0x37, 0x9f, 0x53, 0x76, 0xa3, 0x62, 0x8e,
+	0x0b, 0xe7, 0x91, 0x56, 0x9f, 0x8f, 0xec, 0xa2, 0x66, 0x80, 0xe9, 0xd4, 0x0d, 0xfe, 0x1e, 0xd8,
+	0x53, 0x07, 0x9e, 0x67, 0x19, 0x65, 0xc2, 0x14, 0xd6, 0xf3, 0xdb, 0xc2, 0x69, 0x99, 0x90, 0x6f,
+	0xb4, 0x65, 0x5c, 0x38, 0x8f, 0x17, 0x82, 0x9a, 0x3e, 0x2e, 0x6a, 0x99, 0xb0, 0xc6, 0x15, 0x72,
+	0xd0, 0x24, 0x51, 0x76, 0x74, 0xf2, 0x89, 0xc9, 0xa8, 0xa6, 0x32, 0xba, 0x7c, 0x50, 0x46, 0x8d,
+	0xb3, 0xf3, 0xcb, 0xa3, 0x93, 0x4f, 0x26, 0x09, 0x99, 0x4a, 0x2a, 0x87, 0x75, 0x51, 0x43, 0x43,
+	0x9d, 0xcd, 0x39, 0x30, 0xd0, 0xbb, 0xc6, 0xfc, 0x5a, 0x15, 0x69, 0xbd, 0xb7, 0x7f, 0x5b, 0x38,
+	0x40, 0x47, 0xfa, 0x12, 0xf3, 0xeb, 0xd9, 0xba, 0xf4, 0x47, 0x7f, 0xc0, 0xa9, 0x88, 0xf2, 0x64,
+	0x12, 0x0b, 0xe8, 0xce, 0xd2, 0x6b, 0x3a, 0xfe, 0x13, 0x33, 0xfe, 0xf5, 0xa5, 0xc7, 0x7f, 0xf2,
+	0xa1, 0xf1, 0x9f, 0xcc, 0x8f, 0x5f, 0xfb, 0x4c, 0x45, 0x5f, 0x18, 0xd1, 0x8d, 0xa5, 0x45, 0x5f,
+	0x7c, 0x48, 0xf4, 0xc5, 0xbc, 0xa8, 0xf6, 0x91, 0xc5, 0xbe, 0x30, 0x13, 0x5d, 0x6b, 0xf9, 0x62,
+	0xbf, 0x37, 0xa9, 0xad, 0x29, 0xa3, 0xe5, 0xfe, 0x04, 0x3a, 0x3e, 0x4d, 0xb9, 0x90, 0x5c, 0x4a,
+	0xb3, 0x98, 0x18, 0xcd, 0xba, 0xd2, 0x3c, 0x7f, 0x90, 0xe6, 0x53, 0xf3, 0x6d, 0xf9, 0x40, 0x3c,
+	0x17, 0x6d, 0xcf, 0xd3, 0x5a, 0x3d, 0x03, 0x76, 0x46, 0x04, 0x61, 0xbc, 0x9f, 0xb3, 0xd0, 0x28,
+	0x03, 0xa5, 0x7c, 0xf6, 0x20, 0x65, 0xb3, 0x0f, 0x16, 0x63, 0xb9, 0xa8, 0x3d, 0xa3, 0xb4, 0xe2,
+	0x5b, 0xd0, 0x8a, 0xe4, 0x30, 0xfa, 0x79, 0x6c, 0xf4, 0x1a, 0x4a, 0xef, 0xe5, 0x83, 0xf4, 0xcc,
+	0x66, 0x9e, 0x8f, 0xe4, 0xa2, 0xcd, 0x09, 0xa1, 0xb5, 0x72, 0x00, 0x93, 0x3c, 0x62, 0x5e, 0x18,
+	0x63, 0x3f, 0x22, 0xcc, 0xe8, 0x35, 0x95, 0xde, 0x17, 0x0f, 0xd2, 0x33, 0xdf, 0xcf, 0xfb, 0xd1,
+	0x5c, 0x64, 0x4b, 0xf2, 0x0b, 0xcd, 0x69, 0xd9, 0x00, 0x34, 0xfb, 0x84, 0xc5, 0x51, 0x6a, 0x04,
+	0x37, 0x95, 0xe0, 0xe9, 0x83, 0x04, 0x4d, 0x9d, 0x96, 0xe3, 0xb8, 0xa8, 0xa1, 0xe1, 0x54, 0x25,
+	0xa6, 0x69, 0x40, 0x27, 0x2a, 0x5b, 0xcb, 0xab, 0x94, 0xe3, 0xb8, 0xa8, 0xa1, 0xa1, 0x56, 0x19,
+	0x82, 0x6d, 0xcc, 0x18, 0x7d, 0xb7, 0x30, 0x87, 0x50, 0x89, 0x7d, 0xf9, 0x20, 0xb1, 0x27, 0xe6,
+	0x0c, 0xba, 0x1f, 0x4e, 0x1e, 0x42, 0x92, 0x9d, 0x9b, 0xc5, 0x1c, 0xc0, 0x90, 0xe1, 0xd1, 0x82,
+	0x70, 0x67, 0xf9, 0xc5, 0xbb, 0x1f, 0xcd, 0x45, 0xb6, 0x24, 0xe7, 0x64, 0xff, 0x08, 0x3a, 0x09,
+	0x61, 0x21, 0xf1, 0x52, 0x22, 0x78, 0x16, 0x47, 0xc2, 0x08, 0x3f, 0x5a, 0x7e, 0x3f, 0x7e, 0x28,
+	0x9e, 0x8b, 0xa0, 0xa2, 0xbf, 0x36, 0xec, 0x74, 0x73, 0xf0, 0x6b, 0x9c, 0x86, 0xd7, 0x38, 0x32,
+	0xb2, 0x3b, 0xcb, 0x6f, 0x8e, 0xf9, 0x48, 0x2e, 0xda, 0x9c, 0x10, 0xd3, 0xfa, 0xf1, 0x71, 0xea,
+	0xe7, 0x93, 0xfa, 0x79, 0xbc, 0x7c, 0xfd, 0x94, 0xe3, 0xc8, 0xcb, 0x8c, 0x82, 0x4a, 0xe5, 0xa2,
+	0x66, 0xb5, 0xec, 0xf6, 0x45, 0xcd, 0x6a, 0xdb, 0xf6, 0x45, 0xcd, 0xb2, 0xed, 0xad, 0x8b, 0x9a,
+	0xb5, 0x6d, 0x77, 0xd0, 0xe6, 0x88, 0xc6, 0xd4, 0x1b, 0x7c, 0xaa, 0x3b, 0xa1, 0x06, 0x79, 0x87,
+	0xb9, 0xf9, 0x46, 0xa2, 0x96, 0x8f, 0x05, 0x8e, 0x47, 0xdc, 0x4c, 0x15, 0xb2, 0xf5, 0x04, 0x96,
+	0x4e, 0xed, 0x43, 0xb0, 0xf6, 0x46, 0xc8, 0x3b, 0xa0, 0x0d, 0xaa, 0x37, 0x64, 0xa4, 0x6f, 0x23,
+	0x48, 0x36, 0x61, 0x07, 0xac, 0x0d, 0x70, 0x9c, 0xeb, 0xcb, 0x64, 0x1d, 0x69, 0xe0, 0x7e, 0x05,
+	0xda, 0x57, 0x0c, 0xa7, 0x5c, 0xde, 0x75, 0x68, 0xfa, 0x9a, 0x86, 0x1c, 0x42, 0x50, 0x53, 0xa7,
+	0xa2, 0xee, 0xab, 0xda, 0xf0, 0x19, 0xa8, 0xc5, 0x34, 0xe4, 0xdd, 0xd5, 0xbd, 0xea, 0x7e, 0xe3,
+	0x18, 0x2e, 0x5c, 0xe7, 0x5e, 0xd3, 0x10, 0x29, 0xbb, 0xfb, 0x8f, 0x55, 0x50, 0x7d, 0x4d, 0x43,
+	0xd8, 0x05, 0x1b, 0x38, 0x08, 0x18, 0xe1, 0xdc, 0x84, 0x99, 0x40, 0xb8, 0x03, 0xd6, 0x05, 0xcd,
+	0x22, 0x5f, 0xc7, 0xaa, 0x23, 0x83, 0xa4, 0x6a, 0x80, 0x05, 0x56, 0x97, 0x8a, 0x26, 0x52, 0x6d,
+	0x78, 0x0c, 0x9a, 0x2a, 0x2d, 0x2f, 0xcd, 0x93, 0x3e, 0x61, 0xea, 0x6e, 0x50, 0xeb, 0xb5, 0xef,
+	0x0a, 0xa7, 0xa1, 0xf8, 0xaf, 0x15, 0x8d, 0xca, 0x00, 0x7e, 0x0c, 0x36, 0xc4, 0xb0, 0x7c, 0xac,
+	0x6f, 0xdf, 0x15, 0x4e, 0x5b, 0xcc, 0x72, 0x94, 0xa7, 0x36, 0x5a, 0x17, 0x43, 0xf9, 0x0b, 0x0f,
+	0x81, 0x25, 0x86, 0x5e, 0x94, 0x06, 0x64, 0xa8, 0x4e, 0xee, 0x5a, 0xaf, 0x73, 0x57, 0x38, 0x76,
+	0xc9, 0xfd, 0x5c, 0xda, 0xd0, 0x86, 0x18, 0xaa, 0x06, 0xfc, 0x18, 0x00, 0x3d, 0x24, 0xa5, 0xa0,
+	0xcf, 0xdd, 0xcd, 0xbb, 0xc2, 0xa9, 0x2b, 0x56, 0xc5, 0x9e, 0x35, 0xa1, 0x0b, 0xd6, 0x74, 0x6c,
+	0x4b, 0xc5, 0x6e, 0xde, 0x15, 0x8e, 0x15, 0xd3, 0x50, 0xc7, 0xd4, 0x26, 0x39, 0x55, 0x8c, 0x24,
+	0x74, 0x40, 0x02, 0x75, 0xb4, 0x59, 0x68, 0x02, 0xdd, 0xbf, 0x57, 0x40, 0xfb, 0x94, 0x67, 0xc4,
+	0x17, 0x67, 0x43, 0xe2, 0xe7, 0x72, 0x34, 0x52, 0xff, 0x2d, 0x8d, 0x52, 0x2f, 0xa3, 0x51, 0x2a,
+	0xba, 0x95, 0x99, 0xbe, 0x64, 0x2f, 0x25, 0x89, 0x66, 0x4d, 0xf8, 0x4b, 0x50, 0xc7, 0x2a, 0x80,
+	0x17, 0x05, 0xe6, 0xae, 0xa8, 0xc6, 0xa0, 0xc9, 0xf3, 0x00, 0x4d, 0x5b, 0x72, 0x18, 0x03, 0xc2,
+	0x78, 0x44, 0x53, 0xb5, 0x04, 0x35, 0x34, 0x81, 0xf0, 0x19, 0xb0, 0x42, 0xcc, 0xbd, 0x9c, 0x93,
+	0xc0, 0xac, 0x40, 0xe3, 0xae, 0x70, 0x36, 0x42, 0xcc, 0xbf, 0xe5, 0x24, 0x40, 0x93, 0x86, 0x2c,
+	0x30, 0xc2, 0x18, 0x65, 0x7a, 0xde, 0x91, 0x06, 0xee, 0x0f, 0xab, 0xc0, 0xba, 0x1a, 0x22, 0xc2,
+	0xf3, 0x58, 0xc0, 0xcf, 0x81, 0xed, 0xd3, 0x54, 0x30, 0xec, 0x0b, 0x6f, 0xae, 0x3e, 0x7a, 0x4f,
+	0x67, 0x67, 0xe5, 0xa2, 0x87, 0x8b, 0xda, 0x13, 0xea, 0xd4, 0x14, 0x51, 0x07, 0xac, 0xf5, 0x63,
+	0x4a, 0x13, 0x95, 0x53, 0x13, 0x69, 0x00, 0xbf, 0x51, 0x4b, 0xaf, 0xea, 0xb4, 0xaa, 0x9e, 0x1d,
+	0xbb, 0x0b, 0x75, 0xba, 0x50, 0xe9, 0xbd, 0x1d, 0xf3, 0xf4, 0x68, 0x69, 0x61, 0xd3, 0xd9, 0x95,
+	0xd5, 0xa1, 0x76, 0x82, 0x0d, 0xaa, 0x8c, 0x08, 0x95, 0x74, 0x13, 0xc9, 0x26, 0x7c, 0x02, 0x2c,
+	0x46, 0x06, 0x84, 0x09, 0x12, 0xa8, 0x34, 0x2d, 0x34, 0xc5, 0xf0, 0xa3, 0xd2, 0x3c, 0xad, 0xeb,
+	0x29, 0x34, 0x53, 0xf3, 0x59, 0xed, 0x87, 0xbf, 0x39, 0x2b, 0x2e, 0x06, 0x8d, 0x53, 0xdf, 0x27,
+	0x9c, 0x5f, 0xe5, 0x59, 0x4c, 0xfe, 0xc7, 0x1e, 0x39, 0x06, 0x4d, 0x2e, 0x28, 0xc3, 0x21, 0xf1,
+	0x6e, 0xc8, 0xc8, 0xec, 0x14, 0x5d, 0xf7, 0x86, 0xff, 0x1d, 0x19, 0x71, 0x54, 0x06, 0x46, 0xe2,
+	0x2f, 0x35, 0xd0, 0xb8, 0x62, 0xd8, 0x27, 0xe6, 0x81, 0x22, 0x77, 0x9b, 0x84, 0xcc, 0x48, 0x18,
+	0x24, 0xb5, 0x45, 0x94, 0x10, 0x9a, 0x0b, 0xf3, 0x39, 0x98, 0x40, 0xd9, 0x83, 0x11, 0x32, 0x24,
+	0xbe, 0x29, 0x03, 0x83, 0xe0, 0x09, 0xd8, 0x0c, 0x22, 0xae, 0x1e, 0x8e, 0x5c, 0x60, 0xff, 0x46,
+	0xa7, 0xdf, 0xb3, 0xef, 0x0a, 0xa7, 0x69, 0x0c, 0x6f, 0x24, 0x8f, 0xe6, 0x10, 0xfc, 0x35, 0x68,
+	0xcf, 0xba, 0xa9, 0xd1, 0xea, 0xd7, 0x5a, 0x0f, 0xde, 0x15, 0x4e, 0x6b, 0xea, 0xaa, 0x2c, 0x68,
+	0x01, 0xcb, 0x65, 0x0e, 0x48, 0x3f, 0x0f, 0xd5, 0xf6, 0xb1, 0x90, 0x06, 0x92, 0x8d, 0xa3, 0x24,
+	0x12, 0x6a, 0xbb, 0xac, 0x21, 0x0d, 0xe0, 0x0b, 0x50, 0xa7, 0x03, 0xc2, 0x58, 0x14, 0x10, 0xde,
+	0x05, 0xff, 0xef, 0xd5, 0x89, 0x66, 0xce, 0x32, 0x33, 0xf3, 0x22, 0x4e, 0x48, 0x42, 0xd9, 0xa8,
+	0xdb, 0x98, 0x65, 0xa6, 0x0d, 0x5f, 0x29, 0x1e, 0xcd, 0x21, 0xd8, 0x03, 0xd0, 0x74, 0x63, 0x44,
+	0xe4, 0x2c, 0xf5, 0xd4, 0xe7, 0xab, 0xa9, 0xfa, 0xaa, 0x8f, 0x88, 0xb6, 0x22, 0x65, 0x7c, 0x85,
+	0x05, 0x46, 0xf7, 0x18, 0xf8, 0x5b, 0x00, 0xf5, 0x82, 0x78, 0x6f, 0x39, 0x9d, 0xbe, 0x99, 0xf5,
+	0xb5, 0x48, 0xe9, 0x6b, 0xab, 0x19, 0xb3, 0xad, 0xd1, 0x05, 0xa7, 0x26, 0x8b, 0x8b, 0x9a, 0x55,
+	0xb3, 0xd7, 0x2e, 0x6a, 0xd6, 0x86, 0x6d, 0x4d, 0x27, 0xcf, 0x64, 0x81, 0xb6, 0x27, 0xb8, 0x34,
+	0xbc, 0xde, 0xf9, 0x8f, 0xb7, 0xbb, 0x95, 0x9f, 0x6e, 0x77, 0x2b, 0xff, 0xb9, 0xdd, 0xad, 0xfc,
+	0xf9, 0xfd, 0xee, 0xca, 0x4f, 0xef, 0x77, 0x57, 0xfe, 0xf5, 0x7e, 0x77, 0xe5, 0xbb, 0xc3, 0xd2,
+	0xd9, 0xa6, 0xa7, 0xed, 0x79, 0x4a, 0xc4, 0x3b, 0xca, 0x6e, 0x0c, 0x94, 0xff, 0x82, 0x0c, 0xd5,
+	0xdf, 0x21, 0xea, 0xa0, 0xeb, 0xaf, 0xab, 0x7f, 0x3a, 0x3e, 0xfd, 0xef, 0x00, 0x4e, 0x79, 0x76,
+	0x63, 0x29, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -814,6 +915,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1305,6 +1415,60 @@ func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AspectExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JoinPoint) > 0 {
+		i -= len(m.JoinPoint)
+		copy(dAtA[i:], m.JoinPoint)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.JoinPoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1568,6 +1732,12 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1734,6 +1904,33 @@ func (m *Log) Size() (n int) {
 	return n
 }
 
+func (m *AspectExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JoinPoint)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvm(uint64(m.Version))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func (m *TxResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2064,6 +2261,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3318,6 +3547,190 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AspectExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,11 @@
 package support
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/artela-network/artela/ethereum/types"
 )
 
@@ -52,5 +55,69 @@ func (gs GenesisState) Validate() error {
 		}
 		seenAccounts[acc.Address] = true
 	}
+	if err := gs.Aspects.Validate(); err != nil {
+		return fmt.Errorf("invalid genesis aspects: %w", err)
+	}
 	return gs.Params.Validate()
 }
+
+// ----------------------------------------------------------------------------
+// 							 Genesis Aspects
+// ----------------------------------------------------------------------------
+
+// Validate performs a basic validation of the aspect store entries. All the aspect
+// store keys start with an address followed by the path separator.
+func (ga GenesisAspects) Validate() error {
+	sections := []struct {
+		name    string
+		entries []AspectStoreEntry
+	}{
+		{"codes", ga.Codes},
+		{"versions", ga.Versions},
+		{"properties", ga.Properties},
+		{"contract bindings", ga.ContractBindings},
+		{"verifier bindings", ga.VerifierBindings},
+		{"refs", ga.Refs},
+		{"join points", ga.JoinPoints},
+		{"states", ga.States},
+	}
+	for _, section := range sections {
+		seenKeys := make(map[string]bool)
+		for _, entry := range section.entries {
+			if _, err := addressFromAspectStoreKey(entry.Key); err != nil {
+				return fmt.Errorf("invalid key %x in aspect %s: %w", entry.Key, section.name, err)
+			}
+			if seenKeys[string(entry.Key)] {
+				return fmt.Errorf("duplicated key %x in aspect %s", entry.Key, section.name)
+			}
+			seenKeys[string(entry.Key)] = true
+		}
+	}
+
+	versions := make(map[common.Address]bool)
+	for _, entry := range ga.Versions {
+		aspectID, _ := addressFromAspectStoreKey(entry.Key)
+		if len(entry.Value) == 0 || len(entry.Value) > 32 {
+			return fmt.Errorf("invalid version of aspect %s", aspectID.Hex())
+		}
+		versions[aspectID] = true
+	}
+	for _, entry := range ga.Codes {
+		aspectID, _ := addressFromAspectStoreKey(entry.Key)
+		if !versions[aspectID] {
+			return fmt.Errorf("version of aspect %s not found", aspectID.Hex())
+		}
+		if len(entry.Value) == 0 {
+			return fmt.Errorf("empty code of aspect %s", aspectID.Hex())
+		}
+	}
+	return nil
+}
+
+// addressFromAspectStoreKey parses the leading address of an aspect store key
+func addressFromAspectStoreKey(key []byte) (common.Address, error) {
+	if len(key) <= common.AddressLength || key[common.AddressLength] != '/' {
+		return common.Address{}, errors.New("key must start with an address and a path separator")
+	}
+	return common.BytesToAddress(key[:common.AddressLength]), nil
+}
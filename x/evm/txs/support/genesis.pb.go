@@ -29,6 +29,8 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// aspects defines the aspect store data of the module.
+	Aspects GenesisAspects `protobuf:"bytes,3,opt,name=aspects,proto3" json:"aspects"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAspects() GenesisAspects {
+	if m != nil {
+		return m.Aspects
+	}
+	return GenesisAspects{}
+}
+
 // GenesisAccount defines an account to be initialized in the genesis states.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -144,35 +153,215 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+// GenesisAspects defines the aspect store data to be initialized in the genesis state.
+// Each field holds the entries stored under the corresponding aspect store prefix,
+// the keys are stored with the prefix stripped.
+type GenesisAspects struct {
+	// codes are the aspect codes of all versions, keyed by {aspectId}/{version}/
+	Codes []AspectStoreEntry `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	// versions are the latest versions of the aspects, keyed by {aspectId}/
+	Versions []AspectStoreEntry `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions"`
+	// properties are the aspect properties, keyed by {aspectId}/{property key}/
+	Properties []AspectStoreEntry `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties"`
+	// contract_bindings are the tx level aspects bound to contracts, keyed by {contract}/
+	ContractBindings []AspectStoreEntry `protobuf:"bytes,4,rep,name=contract_bindings,json=contractBindings,proto3" json:"contract_bindings"`
+	// verifier_bindings are the verifier aspects bound to accounts, keyed by {account}/
+	VerifierBindings []AspectStoreEntry `protobuf:"bytes,5,rep,name=verifier_bindings,json=verifierBindings,proto3" json:"verifier_bindings"`
+	// refs are the accounts bound to the aspects, keyed by {aspectId}/
+	Refs []AspectStoreEntry `protobuf:"bytes,6,rep,name=refs,proto3" json:"refs"`
+	// join_points are the join points of the aspects, keyed by {aspectId}/{version}/
+	JoinPoints []AspectStoreEntry `protobuf:"bytes,7,rep,name=join_points,json=joinPoints,proto3" json:"join_points"`
+	// states are the states of the aspects, keyed by {aspectId}/{key}/
+	States []AspectStoreEntry `protobuf:"bytes,8,rep,name=states,proto3" json:"states"`
+}
+
+func (m *GenesisAspects) Reset()         { *m = GenesisAspects{} }
+func (m *GenesisAspects) String() string { return proto.CompactTextString(m) }
+func (*GenesisAspects) ProtoMessage()    {}
+func (*GenesisAspects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf2439c151f2d46, []int{2}
+}
+func (m *GenesisAspects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAspects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAspects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAspects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAspects.Merge(m, src)
+}
+func (m *GenesisAspects) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAspects) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAspects.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAspects proto.InternalMessageInfo
+
+func (m *GenesisAspects) GetCodes() []AspectStoreEntry {
+	if m != nil {
+		return m.Codes
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetVersions() []AspectStoreEntry {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetProperties() []AspectStoreEntry {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetContractBindings() []AspectStoreEntry {
+	if m != nil {
+		return m.ContractBindings
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetVerifierBindings() []AspectStoreEntry {
+	if m != nil {
+		return m.VerifierBindings
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetRefs() []AspectStoreEntry {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetJoinPoints() []AspectStoreEntry {
+	if m != nil {
+		return m.JoinPoints
+	}
+	return nil
+}
+
+func (m *GenesisAspects) GetStates() []AspectStoreEntry {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+// AspectStoreEntry defines a key value pair of the aspect store.
+type AspectStoreEntry struct {
+	// key defines the store key without the aspect store prefix.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the stored value.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AspectStoreEntry) Reset()         { *m = AspectStoreEntry{} }
+func (m *AspectStoreEntry) String() string { return proto.CompactTextString(m) }
+func (*AspectStoreEntry) ProtoMessage()    {}
+func (*AspectStoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf2439c151f2d46, []int{3}
+}
+func (m *AspectStoreEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectStoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectStoreEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectStoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectStoreEntry.Merge(m, src)
+}
+func (m *AspectStoreEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectStoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectStoreEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectStoreEntry proto.InternalMessageInfo
+
+func (m *AspectStoreEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AspectStoreEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "artela.evm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "artela.evm.v1.GenesisAccount")
+	proto.RegisterType((*GenesisAspects)(nil), "artela.evm.v1.GenesisAspects")
+	proto.RegisterType((*AspectStoreEntry)(nil), "artela.evm.v1.AspectStoreEntry")
 }
 
 func init() { proto.RegisterFile("artela/evm/v1/genesis.proto", fileDescriptor_1bf2439c151f2d46) }
 
 var fileDescriptor_1bf2439c151f2d46 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x48, 0xea, 0xa5, 0x96, 0xe5, 0xea,
-	0x95, 0x19, 0x4a, 0x89, 0xa3, 0xaa, 0x05, 0x89, 0x82, 0xd5, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x5e, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x3d, 0x17, 0x47, 0x62, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x49, 0xb1,
-	0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xac, 0x1e, 0x8a, 0x0d, 0x7a, 0x50, 0xe5, 0x8e, 0x10,
-	0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xc1, 0x35, 0x09, 0x19, 0x73, 0xb1, 0x15, 0x24,
-	0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xa2, 0x69, 0x0f, 0x00,
-	0x4b, 0x42, 0xb5, 0x41, 0x95, 0x2a, 0xd5, 0x73, 0xf1, 0xa1, 0x1a, 0x2b, 0x24, 0xc1, 0xc5, 0x9e,
-	0x98, 0x92, 0x52, 0x94, 0x5a, 0x0c, 0x72, 0x06, 0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc4,
-	0xc5, 0x92, 0x9c, 0x9f, 0x92, 0x0a, 0x36, 0x9e, 0x33, 0x08, 0xcc, 0x16, 0xb2, 0xe7, 0x62, 0x2f,
-	0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0x95, 0x60, 0x06, 0x3b, 0x5a, 0x04, 0xcd, 0x56, 0xb0, 0xe7,
-	0x9c, 0xf8, 0x41, 0x96, 0xae, 0xba, 0x2f, 0xcf, 0x1e, 0x0c, 0x51, 0x1c, 0x04, 0xd3, 0xe5, 0xe4,
-	0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x33, 0x75, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b,
-	0xb2, 0xa1, 0x5c, 0x50, 0x30, 0x57, 0x80, 0xc3, 0xbb, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x1c, 0xb2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x89, 0x20, 0x95, 0xb6, 0x01, 0x00,
-	0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0x5f, 0xfe, 0x38, 0x9d, 0xe4, 0x07, 0x61, 0x15, 0x84, 0x55, 0x84, 0x13, 0xe5,
+	0x94, 0x0b, 0xb6, 0xda, 0x9e, 0x00, 0x55, 0x55, 0x23, 0x15, 0xc4, 0xad, 0x72, 0x6f, 0x5c, 0xaa,
+	0x8d, 0x33, 0x35, 0x4b, 0x1b, 0xaf, 0xb5, 0xbb, 0x31, 0xe4, 0xc4, 0x2b, 0xf0, 0x1c, 0xbc, 0x05,
+	0xe2, 0xd2, 0x63, 0x8f, 0x9c, 0x00, 0x25, 0x2f, 0x82, 0x76, 0xd7, 0x4e, 0x49, 0x0e, 0x48, 0xbe,
+	0xcd, 0xee, 0x7e, 0x3f, 0xdf, 0x99, 0x1d, 0x8d, 0x06, 0x9e, 0x52, 0xa1, 0xf0, 0x86, 0x86, 0x98,
+	0xcf, 0xc3, 0xfc, 0x20, 0x4c, 0x30, 0x45, 0xc9, 0x64, 0x90, 0x09, 0xae, 0x38, 0xf9, 0xdf, 0x3e,
+	0x06, 0x98, 0xcf, 0x83, 0xfc, 0x60, 0xff, 0xc9, 0xb6, 0x56, 0xdf, 0x1a, 0xdd, 0x7e, 0x3f, 0xe1,
+	0x09, 0x37, 0x61, 0xa8, 0x23, 0x7b, 0x3b, 0xfa, 0xee, 0x40, 0xf7, 0x8d, 0xf5, 0xbb, 0x50, 0x54,
+	0x21, 0x39, 0x81, 0x36, 0x8d, 0x63, 0xbe, 0x48, 0x95, 0xf4, 0x9c, 0x61, 0x7d, 0xdc, 0x39, 0x7c,
+	0x16, 0x6c, 0x65, 0x08, 0x0a, 0xf9, 0xa9, 0x55, 0x4d, 0x1a, 0xb7, 0x3f, 0x07, 0xb5, 0x68, 0x03,
+	0x91, 0x23, 0x68, 0x65, 0x54, 0xd0, 0xb9, 0xf4, 0xfe, 0x1b, 0x3a, 0xe3, 0xce, 0xe1, 0xe3, 0x1d,
+	0xfc, 0xdc, 0x3c, 0x16, 0x58, 0x21, 0x25, 0xc7, 0xe0, 0x52, 0x99, 0x61, 0xac, 0xa4, 0x57, 0x1f,
+	0x3a, 0xff, 0x48, 0x6a, 0x45, 0x05, 0x5d, 0x32, 0xa3, 0xcf, 0xf0, 0x60, 0xbb, 0x2a, 0xe2, 0x81,
+	0x4b, 0x67, 0x33, 0x81, 0x52, 0xff, 0xc2, 0x19, 0xef, 0x45, 0xe5, 0x91, 0x10, 0x68, 0xc4, 0x7c,
+	0x86, 0xa6, 0xba, 0xbd, 0xc8, 0xc4, 0xe4, 0x04, 0x5c, 0xa9, 0xb8, 0xa0, 0x09, 0x7a, 0x75, 0xf3,
+	0xe7, 0xfe, 0x4e, 0x7a, 0xd3, 0x9b, 0xc9, 0x43, 0x9d, 0xf5, 0xeb, 0xaf, 0x81, 0x7b, 0x61, 0xc5,
+	0x51, 0x49, 0x8d, 0xbe, 0x35, 0xee, 0x2b, 0xb0, 0x35, 0x91, 0x57, 0xd0, 0xd4, 0xde, 0x65, 0x17,
+	0x07, 0x3b, 0x8e, 0x56, 0xa6, 0x8d, 0xf0, 0x2c, 0x55, 0x62, 0x59, 0x7c, 0xc9, 0x32, 0xe4, 0x14,
+	0xda, 0x39, 0x0a, 0xc9, 0x78, 0xaa, 0xdb, 0x58, 0x81, 0xdf, 0x60, 0xe4, 0x0c, 0x20, 0x13, 0x3c,
+	0x43, 0xa1, 0x18, 0x4a, 0xaf, 0x5e, 0xc5, 0xe4, 0x2f, 0x90, 0x44, 0xf0, 0x28, 0xe6, 0xa9, 0x12,
+	0x34, 0x56, 0x97, 0x53, 0x96, 0xce, 0x58, 0x9a, 0x48, 0xaf, 0x51, 0xc5, 0xad, 0x57, 0xf2, 0x93,
+	0x02, 0xd7, 0x9e, 0x39, 0x0a, 0x76, 0xc5, 0x50, 0xdc, 0x7b, 0x36, 0x2b, 0x79, 0x96, 0xfc, 0xc6,
+	0xf3, 0x05, 0x34, 0x04, 0x5e, 0x49, 0xaf, 0x55, 0xc5, 0xc6, 0x20, 0xe4, 0x35, 0x74, 0x3e, 0x70,
+	0x96, 0x5e, 0x66, 0x9c, 0xe9, 0xa9, 0x77, 0x2b, 0xb5, 0x4a, 0x93, 0xe7, 0x06, 0x24, 0xc7, 0xd0,
+	0x92, 0x7a, 0x4e, 0xa4, 0xd7, 0xae, 0x62, 0x51, 0x40, 0xa3, 0x97, 0xd0, 0xdb, 0x55, 0x90, 0x1e,
+	0xd4, 0xaf, 0x71, 0x69, 0x46, 0xb8, 0x1b, 0xe9, 0x90, 0xf4, 0xa1, 0x99, 0xd3, 0x9b, 0x85, 0x9d,
+	0xdf, 0x6e, 0x64, 0x0f, 0x93, 0xb7, 0xb7, 0x2b, 0xdf, 0xb9, 0x5b, 0xf9, 0xce, 0xef, 0x95, 0xef,
+	0x7c, 0x59, 0xfb, 0xb5, 0xbb, 0xb5, 0x5f, 0xfb, 0xb1, 0xf6, 0x6b, 0xef, 0xc2, 0x84, 0xa9, 0xf7,
+	0x8b, 0x69, 0x10, 0xf3, 0x79, 0x68, 0xcb, 0x79, 0x9e, 0xa2, 0xfa, 0xc8, 0xc5, 0x75, 0x71, 0xd4,
+	0x5b, 0xe2, 0x93, 0x59, 0x17, 0x6a, 0x99, 0xa1, 0x9c, 0xb6, 0xcc, 0x62, 0x38, 0xfa, 0x33, 0x00,
+	0x7c, 0xaa, 0x4e, 0x9b, 0x75, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +384,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Aspects.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -273,6 +472,178 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAspects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAspects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAspects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.JoinPoints) > 0 {
+		for iNdEx := len(m.JoinPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JoinPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Refs) > 0 {
+		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VerifierBindings) > 0 {
+		for iNdEx := len(m.VerifierBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifierBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractBindings) > 0 {
+		for iNdEx := len(m.ContractBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Codes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectStoreEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectStoreEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectStoreEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -298,6 +669,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Aspects.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -324,29 +697,103 @@ func (m *GenesisAccount) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
+func (m *GenesisAspects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractBindings) > 0 {
+		for _, e := range m.ContractBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifierBindings) > 0 {
+		for _, e := range m.VerifierBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Refs) > 0 {
+		for _, e := range m.Refs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JoinPoints) > 0 {
+		for _, e := range m.JoinPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AspectStoreEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
 				break
 			}
 		}
@@ -426,6 +873,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aspects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Aspects.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -595,6 +1075,446 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisAspects) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAspects: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAspects: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codes = append(m.Codes, AspectStoreEntry{})
+			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, AspectStoreEntry{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, AspectStoreEntry{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractBindings = append(m.ContractBindings, AspectStoreEntry{})
+			if err := m.ContractBindings[len(m.ContractBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierBindings = append(m.VerifierBindings, AspectStoreEntry{})
+			if err := m.VerifierBindings[len(m.VerifierBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refs = append(m.Refs, AspectStoreEntry{})
+			if err := m.Refs[len(m.Refs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinPoints = append(m.JoinPoints, AspectStoreEntry{})
+			if err := m.JoinPoints[len(m.JoinPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, AspectStoreEntry{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectStoreEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectStoreEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectStoreEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
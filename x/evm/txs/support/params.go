@@ -6,6 +6,7 @@ import (
 
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	paramsmodule "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/artela-network/artela-evm/vm"
@@ -90,6 +91,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -102,6 +107,16 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// IsActivePrecompile returns true if the stateful precompiled contract of the address is enabled
+func (p Params) IsActivePrecompile(address common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == address {
+			return true
+		}
+	}
+	return false
+}
+
 // Deprecated: ParamKeyTable returns the parameter key table.
 // Usage of x/params to manage parameters is deprecated in favor of x/gov
 // controlled execution of MsgUpdateParams messages. These types remain solely
@@ -157,6 +172,27 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]struct{}, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+		address := common.HexToAddress(precompile)
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicate precompile address %s", precompile)
+		}
+		seen[address] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
@@ -44,9 +44,10 @@ const (
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx      = TypeMsgEthereumTx
+	EventTypeBlockBloom      = "block_bloom"
+	EventTypeTxLog           = "tx_log"
+	EventTypeAspectExecution = "aspect_execution"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -56,6 +57,7 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyAspectExecution = "aspectExecution"
 	// AttributeKeyEthereumTxFailed txs failed in evm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName